@@ -0,0 +1,152 @@
+package base
+
+import (
+	"context"
+	"errors"
+
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DynamicResource identifies a resource without a typed client, usually one
+// defined through a CustomResourceDefinition
+type DynamicResource struct {
+	Resource schema.GroupVersionResource
+	Kind     string
+}
+
+// DynamicAPI serves any resource through the dynamic client using
+// unstructured objects, an empty namespace targets cluster scoped resources
+type DynamicAPI struct {
+	Client   dynamic.Interface
+	Context  context.Context
+	Opts     QueryOpts
+	Resource DynamicResource
+}
+
+// Config only keeps the context, the dynamic client is set by ConfigDynamic
+func (api *DynamicAPI) Config(ctx context.Context, _ kubernetes.Interface) {
+	api.Context = ctx
+}
+
+func (api *DynamicAPI) ConfigDynamic(client dynamic.Interface, res DynamicResource) {
+	api.Client = client
+	api.Resource = res
+}
+
+func (api *DynamicAPI) SetOpts(opts QueryOpts) {
+	api.Opts = opts
+}
+
+func (api *DynamicAPI) resource(namespace string) (dynamic.ResourceInterface, error) {
+	if api.Client == nil {
+		return nil, errors.New(skerr.ERROR_NO_DYNAMIC_CLIENT)
+	}
+	res := api.Client.Resource(api.Resource.Resource)
+	if namespace == "" {
+		return res, nil
+	}
+	return res.Namespace(namespace), nil
+}
+
+// setType fills apiVersion, kind and namespace when the simplified struct
+// doesn't map them
+func (api *DynamicAPI) setType(namespace string, obj *unstructured.Unstructured) {
+	if obj.GetAPIVersion() == "" {
+		obj.SetAPIVersion(api.Resource.Resource.GroupVersion().String())
+	}
+	if obj.GetKind() == "" {
+		obj.SetKind(api.Resource.Kind)
+	}
+	if obj.GetNamespace() == "" && namespace != "" {
+		obj.SetNamespace(namespace)
+	}
+}
+
+func (api *DynamicAPI) Get(name, namespace string) (interface{}, error) {
+	client, err := api.resource(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return client.Get(api.Context, name, metav1.GetOptions{})
+}
+
+func (api *DynamicAPI) Create(namespace string, obj interface{}) error {
+	client, err := api.resource(namespace)
+	if err != nil {
+		return err
+	}
+	res := obj.(*unstructured.Unstructured)
+	api.setType(namespace, res)
+	_, err = client.Create(api.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+// Update carries over the current resourceVersion when it's not set, custom
+// resources don't allow unconditional updates
+func (api *DynamicAPI) Update(namespace string, obj interface{}) error {
+	client, err := api.resource(namespace)
+	if err != nil {
+		return err
+	}
+	res := obj.(*unstructured.Unstructured)
+	api.setType(namespace, res)
+	if res.GetResourceVersion() == "" {
+		current, err := client.Get(api.Context, res.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		res.SetResourceVersion(current.GetResourceVersion())
+	}
+	_, err = client.Update(api.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (api *DynamicAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	client, err := api.resource(namespace)
+	if err != nil {
+		return res, err
+	}
+	list, err := client.List(api.Context, api.Opts.List)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (api *DynamicAPI) Delete(name, namespace string) error {
+	client, err := api.resource(namespace)
+	if err != nil {
+		return err
+	}
+	return client.Delete(api.Context, name, metav1.DeleteOptions{})
+}
+
+// DumpUnstructured maps a simplified struct into an unstructured object
+func DumpUnstructured(from interface{}) (interface{}, error) {
+	res := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	err := sm.Marshal(from, &res.Object)
+	return res, err
+}
+
+// LoadUnstructured maps an unstructured object, as returned by DynamicAPI,
+// into a simplified struct
+func LoadUnstructured(from, into interface{}) error {
+	switch obj := from.(type) {
+	case *unstructured.Unstructured:
+		return sm.Unmarshal(obj.Object, into)
+	case unstructured.Unstructured:
+		return sm.Unmarshal(obj.Object, into)
+	}
+	return sm.Unmarshal(from, into)
+}
@@ -0,0 +1,26 @@
+package base
+
+// RBAC types shared by namespaced roles and bindings and their cluster scoped
+// counterparts
+
+type PolicyRule struct {
+	APIGroups     []string `sm:"apiGroups"`
+	Resources     []string `sm:"resources"`
+	ResourceNames []string `sm:"resourceNames"`
+	Verbs         []string `sm:"verbs"`
+	// Only valid on ClusterRoles, paths like "/healthz" or "/metrics/*"
+	NonResourceURLs []string `sm:"nonResourceURLs"`
+}
+
+type RoleRef struct {
+	APIGroup string `sm:"apiGroup"`
+	Kind     string `sm:"kind"`
+	Name     string `sm:"name"`
+}
+
+type Subject struct {
+	Kind      string `sm:"kind"`
+	APIGroup  string `sm:"apiGroup"`
+	Name      string `sm:"name"`
+	Namespace string `sm:"namespace"`
+}
@@ -12,3 +12,14 @@ type ResourceInterface interface {
 	Load(from, into interface{}) error
 	Dump(from interface{}) (interface{}, error)
 }
+
+type LabelSelector struct {
+	MatchLabels      map[string]string          `sm:"matchLabels"`
+	MatchExpressions []LabelSelectorRequirement `sm:"matchExpressions"`
+}
+
+type LabelSelectorRequirement struct {
+	Key      string                       `sm:"key"`
+	Operator metav1.LabelSelectorOperator `sm:"operator"`
+	Values   []string                     `sm:"values"`
+}
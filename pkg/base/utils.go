@@ -16,3 +16,9 @@ func FlattenLabels(labels map[string]string) string {
 	}
 	return flatLabels
 }
+
+// InvalidObjectError is returned by Dump when it gets something other than
+// the simplified struct it was called for
+func InvalidObjectError(expected, got interface{}) error {
+	return fmt.Errorf("invalid object: expected %T, got %T", expected, got)
+}
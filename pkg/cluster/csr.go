@@ -0,0 +1,75 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	"github.com/ilexPar/simple-kube/pkg/errors"
+)
+
+type CertificateSigningRequestActions struct {
+	*Action[resources.CertificateSigningRequest]
+	requests *resources.CertificateSigningRequestAPI
+}
+
+func (ca *CertificateSigningRequestActions) Approve(
+	name, reason, message string,
+) CertificateSigningRequestApprovalInterface {
+	return &CertificateSigningRequestApproval{
+		requests: ca.requests,
+		Id:       name,
+		approve:  true,
+		reason:   reason,
+		message:  message,
+	}
+}
+
+func (ca *CertificateSigningRequestActions) Deny(
+	name, reason, message string,
+) CertificateSigningRequestApprovalInterface {
+	return &CertificateSigningRequestApproval{
+		requests: ca.requests,
+		Id:       name,
+		approve:  false,
+		reason:   reason,
+		message:  message,
+	}
+}
+
+func (ca *CertificateSigningRequestActions) WaitForCertificate(
+	name string,
+	timeout time.Duration,
+) CertificateSigningRequestWaitInterface {
+	return &CertificateSigningRequestWait{
+		requests: ca.requests,
+		Id:       name,
+		timeout:  timeout,
+	}
+}
+
+type CertificateSigningRequestApproval struct {
+	requests *resources.CertificateSigningRequestAPI
+	Id       string
+	approve  bool
+	reason   string
+	message  string
+}
+
+func (a *CertificateSigningRequestApproval) Run() error {
+	if a.approve {
+		return errors.Format(a.requests.Approve(a.Id, a.reason, a.message))
+	}
+	return errors.Format(a.requests.Deny(a.Id, a.reason, a.message))
+}
+
+type CertificateSigningRequestWait struct {
+	requests *resources.CertificateSigningRequestAPI
+	Id       string
+	timeout  time.Duration
+}
+
+// Run returns the PEM encoded certificate issued by the signer
+func (w *CertificateSigningRequestWait) Run() ([]byte, error) {
+	cert, err := w.requests.WaitForCertificate(w.Id, w.timeout)
+	return cert, errors.Format(err)
+}
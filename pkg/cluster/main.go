@@ -6,6 +6,7 @@ import (
 	"github.com/ilexPar/simple-kube/pkg/base"
 	"github.com/ilexPar/simple-kube/pkg/cluster/resources"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -70,11 +71,19 @@ func NewQuery(ctx context.Context, client kubernetes.Interface) *Query {
 }
 
 type Query struct {
-	ctx    context.Context
-	client kubernetes.Interface
+	ctx     context.Context
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
 }
 
-func (c *Query) getResourceAPI(
+// WithDynamicClient sets the client used to serve custom resources
+func (c *Query) WithDynamicClient(client dynamic.Interface) *Query {
+	c.dynamic = client
+	return c
+}
+
+// ResourceAPI returns the API of res configured with the query client
+func (c *Query) ResourceAPI(
 	res ClusterResourceMethods,
 ) resources.ClusterResourceAPI {
 	api := res.API()
@@ -82,10 +91,97 @@ func (c *Query) getResourceAPI(
 	return api
 }
 
+// DynamicAPI returns an API serving def through the query dynamic client
+func (c *Query) DynamicAPI(
+	def base.DynamicResource,
+) resources.ClusterResourceAPI {
+	api := &resources.CustomResourceAPI{}
+	api.Config(c.ctx, c.client)
+	api.ConfigDynamic(c.dynamic, def)
+	return api
+}
+
+// For returns the actions for any type implementing ClusterResourceMethods,
+// so resources not shipped with the library can be plugged in
+func For[T ClusterResources](query QueryCluster) ClusterAction[T] {
+	res := *new(T)
+	return NewClusterAction(
+		res,
+		query.ResourceAPI(res),
+	)
+}
+
+// Custom returns the actions for a resource served through the dynamic client,
+// T is usually a simplified struct embedding resources.CustomResource
+func Custom[T ClusterResources](
+	query QueryCluster,
+	def base.DynamicResource,
+) ClusterAction[T] {
+	res := *new(T)
+	return NewClusterAction(
+		res,
+		query.DynamicAPI(def),
+	)
+}
+
 func (c *Query) Namespace() ClusterAction[resources.Namespace] {
-	res := resources.Namespace{}
+	return For[resources.Namespace](c)
+}
+
+func (c *Query) ClusterRole() ClusterAction[resources.ClusterRole] {
+	return For[resources.ClusterRole](c)
+}
+
+func (c *Query) ClusterRoleBinding() ClusterAction[resources.ClusterRoleBinding] {
+	return For[resources.ClusterRoleBinding](c)
+}
+
+func (c *Query) Node() NodeAction {
+	res := resources.Node{}
+	api := c.ResourceAPI(res)
+	return &NodeActions{
+		NewClusterAction(res, api),
+		api.(*resources.NodeAPI),
+	}
+}
+
+func (c *Query) StorageClass() ClusterAction[resources.StorageClass] {
+	return For[resources.StorageClass](c)
+}
+
+func (c *Query) PersistentVolume() ClusterAction[resources.PersistentVolume] {
+	return For[resources.PersistentVolume](c)
+}
+
+func (c *Query) PriorityClass() ClusterAction[resources.PriorityClass] {
+	return For[resources.PriorityClass](c)
+}
+
+func (c *Query) CustomResourceDefinition() ClusterAction[resources.CustomResourceDefinition] {
+	res := resources.CustomResourceDefinition{}
 	return NewClusterAction(
 		res,
-		c.getResourceAPI(res),
+		c.DynamicAPI(resources.CustomResourceDefinitionResource),
 	)
 }
+
+func (c *Query) IngressClass() ClusterAction[resources.IngressClass] {
+	return For[resources.IngressClass](c)
+}
+
+func (c *Query) ValidatingWebhookConfiguration() ClusterAction[resources.ValidatingWebhookConfiguration] {
+	return For[resources.ValidatingWebhookConfiguration](c)
+}
+
+func (c *Query) MutatingWebhookConfiguration() ClusterAction[resources.MutatingWebhookConfiguration] {
+	return For[resources.MutatingWebhookConfiguration](c)
+}
+
+func (c *Query) CertificateSigningRequest() CertificateSigningRequestAction {
+	res := resources.CertificateSigningRequest{}
+	api := c.ResourceAPI(res)
+	return &CertificateSigningRequestActions{
+		NewClusterAction(res, api),
+		api.(*resources.CertificateSigningRequestAPI),
+	}
+}
@@ -0,0 +1,60 @@
+package cluster
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	"github.com/ilexPar/simple-kube/pkg/errors"
+)
+
+type NodeActions struct {
+	*Action[resources.Node]
+	nodes *resources.NodeAPI
+}
+
+func (na *NodeActions) Cordon(name string) NodeScheduleInterface {
+	return &NodeSchedule{
+		nodes:         na.nodes,
+		Id:            name,
+		unschedulable: true,
+	}
+}
+
+func (na *NodeActions) Uncordon(name string) NodeScheduleInterface {
+	return &NodeSchedule{
+		nodes:         na.nodes,
+		Id:            name,
+		unschedulable: false,
+	}
+}
+
+func (na *NodeActions) Drain(
+	name string,
+	opts resources.DrainOptions,
+) NodeDrainInterface {
+	return &NodeDrain{
+		nodes: na.nodes,
+		Id:    name,
+		opts:  opts,
+	}
+}
+
+type NodeSchedule struct {
+	nodes         *resources.NodeAPI
+	Id            string
+	unschedulable bool
+}
+
+func (s *NodeSchedule) Run() error {
+	err := s.nodes.SetUnschedulable(s.Id, s.unschedulable)
+	return errors.Format(err)
+}
+
+type NodeDrain struct {
+	nodes *resources.NodeAPI
+	Id    string
+	opts  resources.DrainOptions
+}
+
+func (d *NodeDrain) Run() (resources.DrainResult, error) {
+	res, err := d.nodes.Drain(d.Id, d.opts)
+	return res, errors.Format(err)
+}
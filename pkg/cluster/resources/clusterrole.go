@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ClusterRole struct {
+	Name        string               `sm:"metadata.name"`
+	Labels      map[string]string    `sm:"metadata.labels"`
+	Rules       []base.PolicyRule    `sm:"rules"`
+	Aggregation []base.LabelSelector `sm:"aggregationRule.clusterRoleSelectors"`
+}
+
+func (cr ClusterRole) API() ClusterResourceAPI {
+	return &ClusterRoleAPI{}
+}
+
+func (cr ClusterRole) Dump(from interface{}) (interface{}, error) {
+	res := &rbac.ClusterRole{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (cr ClusterRole) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type ClusterRoleAPI struct {
+	base.KubeAPI
+}
+
+func (cr *ClusterRoleAPI) Get(name string) (interface{}, error) {
+	res, err := cr.Client.RbacV1().
+		ClusterRoles().
+		Get(cr.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (cr *ClusterRoleAPI) Create(obj interface{}) error {
+	res := obj.(*rbac.ClusterRole)
+	_, err := cr.Client.RbacV1().
+		ClusterRoles().
+		Create(cr.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (cr *ClusterRoleAPI) Update(obj interface{}) error {
+	res := obj.(*rbac.ClusterRole)
+	_, err := cr.Client.RbacV1().
+		ClusterRoles().
+		Update(cr.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (cr *ClusterRoleAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := cr.Client.RbacV1().
+		ClusterRoles().
+		List(cr.Context, cr.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (cr *ClusterRoleAPI) Delete(name string) error {
+	return cr.Client.RbacV1().
+		ClusterRoles().
+		Delete(cr.Context, name, metav1.DeleteOptions{})
+}
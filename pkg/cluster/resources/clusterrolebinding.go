@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ClusterRoleBinding struct {
+	Name     string            `sm:"metadata.name"`
+	Labels   map[string]string `sm:"metadata.labels"`
+	RoleRef  base.RoleRef      `sm:"roleRef"`
+	Subjects []base.Subject    `sm:"subjects"`
+}
+
+func (crb ClusterRoleBinding) API() ClusterResourceAPI {
+	return &ClusterRoleBindingAPI{}
+}
+
+func (crb ClusterRoleBinding) Dump(from interface{}) (interface{}, error) {
+	res := &rbac.ClusterRoleBinding{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (crb ClusterRoleBinding) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type ClusterRoleBindingAPI struct {
+	base.KubeAPI
+}
+
+func (crb *ClusterRoleBindingAPI) Get(name string) (interface{}, error) {
+	res, err := crb.Client.RbacV1().
+		ClusterRoleBindings().
+		Get(crb.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (crb *ClusterRoleBindingAPI) Create(obj interface{}) error {
+	res := obj.(*rbac.ClusterRoleBinding)
+	_, err := crb.Client.RbacV1().
+		ClusterRoleBindings().
+		Create(crb.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (crb *ClusterRoleBindingAPI) Update(obj interface{}) error {
+	res := obj.(*rbac.ClusterRoleBinding)
+	_, err := crb.Client.RbacV1().
+		ClusterRoleBindings().
+		Update(crb.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (crb *ClusterRoleBindingAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := crb.Client.RbacV1().
+		ClusterRoleBindings().
+		List(crb.Context, crb.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (crb *ClusterRoleBindingAPI) Delete(name string) error {
+	return crb.Client.RbacV1().
+		ClusterRoleBindings().
+		Delete(crb.Context, name, metav1.DeleteOptions{})
+}
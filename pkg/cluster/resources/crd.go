@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CustomResourceDefinitions are served through the dynamic client, so the
+// query needs one configured
+var CustomResourceDefinitionResource = base.DynamicResource{
+	Resource: schema.GroupVersionResource{
+		Group:    "apiextensions.k8s.io",
+		Version:  "v1",
+		Resource: "customresourcedefinitions",
+	},
+	Kind: "CustomResourceDefinition",
+}
+
+type CustomResourceDefinition struct {
+	CustomResource
+	Name       string                            `sm:"metadata.name"`
+	Labels     map[string]string                 `sm:"metadata.labels"`
+	Group      string                            `sm:"spec.group"`
+	Scope      string                            `sm:"spec.scope"`
+	Kind       string                            `sm:"spec.names.kind"`
+	ListKind   string                            `sm:"spec.names.listKind"`
+	Plural     string                            `sm:"spec.names.plural"`
+	Singular   string                            `sm:"spec.names.singular"`
+	ShortNames []string                          `sm:"spec.names.shortNames"`
+	Categories []string                          `sm:"spec.names.categories"`
+	Versions   []CustomResourceDefinitionVersion `sm:"spec.versions"`
+}
+
+type CustomResourceDefinitionVersion struct {
+	Name         string                 `sm:"name"`
+	Served       bool                   `sm:"served"`
+	Storage      bool                   `sm:"storage"`
+	Deprecated   bool                   `sm:"deprecated"`
+	Schema       map[string]interface{} `sm:"schema.openAPIV3Schema"`
+	Subresources map[string]interface{} `sm:"subresources"`
+}
+
+// DynamicResource returns the definition needed to query the resources of
+// the given version with namespaced.Custom or cluster.Custom
+func (crd CustomResourceDefinition) DynamicResource(version string) base.DynamicResource {
+	return base.DynamicResource{
+		Resource: schema.GroupVersionResource{
+			Group:    crd.Group,
+			Version:  version,
+			Resource: crd.Plural,
+		},
+		Kind: crd.Kind,
+	}
+}
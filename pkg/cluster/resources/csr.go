@@ -0,0 +1,276 @@
+package resources
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	certificates "k8s.io/api/certificates/v1"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type CertificateSigningRequest struct {
+	Name              string                          `sm:"metadata.name"`
+	Labels            map[string]string               `sm:"metadata.labels"`
+	Request           []byte                          `sm:"spec.request"`
+	SignerName        string                          `sm:"spec.signerName"`
+	Usages            []certificates.KeyUsage         `sm:"spec.usages"`
+	ExpirationSeconds int                             `sm:"spec.expirationSeconds"`
+	Status            CertificateSigningRequestStatus `sm:"->"`
+}
+
+// CertificateSigningRequestStatus is only populated by Get and List, it's
+// ignored on Create and Update
+type CertificateSigningRequestStatus struct {
+	Conditions  []CertificateSigningRequestCondition `sm:"status.conditions"`
+	Certificate []byte                               `sm:"status.certificate"`
+}
+
+type CertificateSigningRequestCondition struct {
+	Type    certificates.RequestConditionType `sm:"type"`
+	Status  api.ConditionStatus               `sm:"status"`
+	Reason  string                            `sm:"reason"`
+	Message string                            `sm:"message"`
+}
+
+// CertificateRequestOptions is the subject of a request built with
+// GenerateCertificateRequest
+type CertificateRequestOptions struct {
+	CommonName    string
+	Organizations []string
+	DNSNames      []string
+}
+
+// GenerateCertificateRequest creates an ECDSA P-256 key and a CSR signed with
+// it, both PEM encoded. The CSR goes into CertificateSigningRequest.Request
+// while the key should never leave the requester
+func GenerateCertificateRequest(opts CertificateRequestOptions) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	request, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   opts.CommonName,
+			Organization: opts.Organizations,
+		},
+		DNSNames: opts.DNSNames,
+	}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	requestPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: request})
+	return keyPEM, requestPEM, nil
+}
+
+func (csr CertificateSigningRequest) Approved() bool {
+	return csr.hasCondition(certificates.CertificateApproved)
+}
+
+func (csr CertificateSigningRequest) Denied() bool {
+	return csr.hasCondition(certificates.CertificateDenied)
+}
+
+func (csr CertificateSigningRequest) hasCondition(
+	conditionType certificates.RequestConditionType,
+) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == api.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (csr CertificateSigningRequest) API() ClusterResourceAPI {
+	return &CertificateSigningRequestAPI{}
+}
+
+func (csr CertificateSigningRequest) Dump(from interface{}) (interface{}, error) {
+	request, ok := from.(CertificateSigningRequest)
+	if !ok {
+		return nil, base.InvalidObjectError(CertificateSigningRequest{}, from)
+	}
+	request.Status = CertificateSigningRequestStatus{}
+	res := &certificates.CertificateSigningRequest{}
+	err := sm.Marshal(request, res)
+	return res, err
+}
+
+func (csr CertificateSigningRequest) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type CertificateSigningRequestAPI struct {
+	base.KubeAPI
+}
+
+func (csr *CertificateSigningRequestAPI) Get(name string) (interface{}, error) {
+	res, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Get(csr.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (csr *CertificateSigningRequestAPI) Create(obj interface{}) error {
+	res := obj.(*certificates.CertificateSigningRequest)
+	_, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Create(csr.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (csr *CertificateSigningRequestAPI) Update(obj interface{}) error {
+	res := obj.(*certificates.CertificateSigningRequest)
+	_, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Update(csr.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (csr *CertificateSigningRequestAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		List(csr.Context, csr.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (csr *CertificateSigningRequestAPI) Delete(name string) error {
+	return csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Delete(csr.Context, name, metav1.DeleteOptions{})
+}
+
+func (csr *CertificateSigningRequestAPI) Approve(name, reason, message string) error {
+	return csr.setApproval(name, certificates.CertificateApproved, reason, message)
+}
+
+func (csr *CertificateSigningRequestAPI) Deny(name, reason, message string) error {
+	return csr.setApproval(name, certificates.CertificateDenied, reason, message)
+}
+
+func (csr *CertificateSigningRequestAPI) setApproval(
+	name string,
+	conditionType certificates.RequestConditionType,
+	reason, message string,
+) error {
+	request, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Get(csr.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	for _, condition := range request.Status.Conditions {
+		if condition.Type == conditionType {
+			return nil
+		}
+	}
+
+	request.Status.Conditions = append(request.Status.Conditions, certificates.CertificateSigningRequestCondition{
+		Type:           conditionType,
+		Status:         api.ConditionTrue,
+		Reason:         reason,
+		Message:        message,
+		LastUpdateTime: metav1.Now(),
+	})
+	_, err = csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		UpdateApproval(csr.Context, name, request, metav1.UpdateOptions{})
+	return err
+}
+
+// WaitForCertificate blocks until the signer issues the certificate, the
+// request is denied or failed, or the timeout expires
+func (csr *CertificateSigningRequestAPI) WaitForCertificate(
+	name string,
+	timeout time.Duration,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(csr.Context, timeout)
+	defer cancel()
+
+	// watch before getting the request so an update in between isn't missed
+	watcher, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Watch(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+		})
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	request, err := csr.Client.CertificatesV1().
+		CertificateSigningRequests().
+		Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if cert, done, err := issuedCertificate(request); done {
+			return cert, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for certificate signing request %s", name)
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("watch closed waiting for certificate signing request %s", name)
+			}
+			if event.Type == watch.Deleted {
+				return nil, fmt.Errorf("certificate signing request %s was deleted", name)
+			}
+			obj, ok := event.Object.(*certificates.CertificateSigningRequest)
+			if ok && obj.Name == name {
+				request = obj
+			}
+		}
+	}
+}
+
+func issuedCertificate(request *certificates.CertificateSigningRequest) ([]byte, bool, error) {
+	for _, condition := range request.Status.Conditions {
+		if condition.Status != api.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case certificates.CertificateDenied:
+			return nil, true, fmt.Errorf(
+				"certificate signing request %s was denied: %s",
+				request.Name,
+				condition.Message,
+			)
+		case certificates.CertificateFailed:
+			return nil, true, fmt.Errorf(
+				"certificate signing request %s failed: %s",
+				request.Name,
+				condition.Message,
+			)
+		}
+	}
+	if len(request.Status.Certificate) > 0 {
+		return request.Status.Certificate, true, nil
+	}
+	return nil, false, nil
+}
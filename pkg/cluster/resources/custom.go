@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+)
+
+// CustomResource is meant to be embedded in a simplified struct to serve it
+// through the dynamic client, see cluster.Custom
+type CustomResource struct{}
+
+func (cr CustomResource) API() ClusterResourceAPI {
+	return &CustomResourceAPI{}
+}
+
+func (cr CustomResource) Dump(from interface{}) (interface{}, error) {
+	return base.DumpUnstructured(from)
+}
+
+func (cr CustomResource) Load(from, into interface{}) error {
+	return base.LoadUnstructured(from, into)
+}
+
+type CustomResourceAPI struct {
+	base.DynamicAPI
+}
+
+func (cr *CustomResourceAPI) Get(name string) (interface{}, error) {
+	return cr.DynamicAPI.Get(name, "")
+}
+
+func (cr *CustomResourceAPI) Create(obj interface{}) error {
+	return cr.DynamicAPI.Create("", obj)
+}
+
+func (cr *CustomResourceAPI) Update(obj interface{}) error {
+	return cr.DynamicAPI.Update("", obj)
+}
+
+func (cr *CustomResourceAPI) List() ([]interface{}, error) {
+	return cr.DynamicAPI.List("")
+}
+
+func (cr *CustomResourceAPI) Delete(name string) error {
+	return cr.DynamicAPI.Delete(name, "")
+}
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type IngressClass struct {
+	Name        string                  `sm:"metadata.name"`
+	Labels      map[string]string       `sm:"metadata.labels"`
+	Annotations map[string]string       `sm:"metadata.annotations"`
+	Controller  string                  `sm:"spec.controller"`
+	Parameters  *IngressClassParameters `sm:"spec.parameters"`
+}
+
+// IngressClassParameters references the controller specific configuration,
+// Namespace is only set when Scope is "Namespace"
+type IngressClassParameters struct {
+	APIGroup  string `sm:"apiGroup"`
+	Kind      string `sm:"kind"`
+	Name      string `sm:"name"`
+	Scope     string `sm:"scope"`
+	Namespace string `sm:"namespace"`
+}
+
+// IsDefault reports if the class is used for ingresses without a class name
+func (ic IngressClass) IsDefault() bool {
+	return ic.Annotations[networking.AnnotationIsDefaultIngressClass] == "true"
+}
+
+// SetDefault sets or clears the default class annotation
+func (ic *IngressClass) SetDefault(isDefault bool) {
+	if !isDefault {
+		delete(ic.Annotations, networking.AnnotationIsDefaultIngressClass)
+		return
+	}
+	if ic.Annotations == nil {
+		ic.Annotations = map[string]string{}
+	}
+	ic.Annotations[networking.AnnotationIsDefaultIngressClass] = "true"
+}
+
+func (ic IngressClass) API() ClusterResourceAPI {
+	return &IngressClassAPI{}
+}
+
+func (ic IngressClass) Dump(from interface{}) (interface{}, error) {
+	res := &networking.IngressClass{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (ic IngressClass) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type IngressClassAPI struct {
+	base.KubeAPI
+}
+
+func (ic *IngressClassAPI) Get(name string) (interface{}, error) {
+	res, err := ic.Client.NetworkingV1().
+		IngressClasses().
+		Get(ic.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (ic *IngressClassAPI) Create(obj interface{}) error {
+	res := obj.(*networking.IngressClass)
+	_, err := ic.Client.NetworkingV1().
+		IngressClasses().
+		Create(ic.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (ic *IngressClassAPI) Update(obj interface{}) error {
+	res := obj.(*networking.IngressClass)
+	_, err := ic.Client.NetworkingV1().
+		IngressClasses().
+		Update(ic.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (ic *IngressClassAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := ic.Client.NetworkingV1().
+		IngressClasses().
+		List(ic.Context, ic.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (ic *IngressClassAPI) Delete(name string) error {
+	return ic.Client.NetworkingV1().
+		IngressClasses().
+		Delete(ic.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+type Node struct {
+	Name          string            `sm:"metadata.name"`
+	Labels        map[string]string `sm:"metadata.labels"`
+	Taints        []Taint           `sm:"spec.taints"`
+	Unschedulable bool              `sm:"spec.unschedulable"`
+	Status        NodeStatus        `sm:"->"`
+}
+
+type Taint struct {
+	Key    string          `sm:"key"`
+	Value  string          `sm:"value"`
+	Effect api.TaintEffect `sm:"effect"`
+}
+
+// NodeStatus is only populated by Get and List, it's ignored on Create and Update
+type NodeStatus struct {
+	Capacity    map[string]string `sm:"status.capacity"`
+	Allocatable map[string]string `sm:"status.allocatable"`
+	Conditions  []NodeCondition   `sm:"status.conditions"`
+}
+
+type NodeCondition struct {
+	Type    api.NodeConditionType `sm:"type"`
+	Status  api.ConditionStatus   `sm:"status"`
+	Reason  string                `sm:"reason"`
+	Message string                `sm:"message"`
+}
+
+type DrainOptions struct {
+	// Pods managed by a DaemonSet are left running unless this is set, the
+	// controller would recreate them on the same node right away
+	EvictDaemonSets    bool
+	GracePeriodSeconds *int64
+}
+
+type DrainResult struct {
+	Evicted []PodReference
+	Skipped []PodReference
+	Failed  []DrainFailure
+}
+
+type PodReference struct {
+	Name      string
+	Namespace string
+}
+
+type DrainFailure struct {
+	Pod    PodReference
+	Reason string
+}
+
+func (n Node) API() ClusterResourceAPI {
+	return &NodeAPI{}
+}
+
+func (n Node) Dump(from interface{}) (interface{}, error) {
+	node, ok := from.(Node)
+	if !ok {
+		return nil, base.InvalidObjectError(Node{}, from)
+	}
+	node.Status = NodeStatus{}
+	res := &api.Node{}
+	err := sm.Marshal(node, res)
+	return res, err
+}
+
+func (n Node) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type NodeAPI struct {
+	base.KubeAPI
+}
+
+func (n *NodeAPI) Get(name string) (interface{}, error) {
+	res, err := n.Client.CoreV1().
+		Nodes().
+		Get(n.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (n *NodeAPI) Create(obj interface{}) error {
+	res := obj.(*api.Node)
+	_, err := n.Client.CoreV1().
+		Nodes().
+		Create(n.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+// Update only applies labels, taints and the unschedulable flag onto the live
+// node, everything else is owned by the kubelet or the cloud provider and
+// fields like podCIDR or providerID can't change once set
+func (n *NodeAPI) Update(obj interface{}) error {
+	res := obj.(*api.Node)
+	node, err := n.Client.CoreV1().
+		Nodes().
+		Get(n.Context, res.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	node.Labels = res.Labels
+	node.Spec.Taints = res.Spec.Taints
+	node.Spec.Unschedulable = res.Spec.Unschedulable
+	_, err = n.Client.CoreV1().
+		Nodes().
+		Update(n.Context, node, metav1.UpdateOptions{})
+	return err
+}
+
+func (n *NodeAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := n.Client.CoreV1().
+		Nodes().
+		List(n.Context, n.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (n *NodeAPI) Delete(name string) error {
+	return n.Client.CoreV1().
+		Nodes().
+		Delete(n.Context, name, metav1.DeleteOptions{})
+}
+
+func (n *NodeAPI) SetUnschedulable(name string, unschedulable bool) error {
+	node, err := n.Client.CoreV1().
+		Nodes().
+		Get(n.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if node.Spec.Unschedulable == unschedulable {
+		return nil
+	}
+
+	node.Spec.Unschedulable = unschedulable
+	_, err = n.Client.CoreV1().
+		Nodes().
+		Update(n.Context, node, metav1.UpdateOptions{})
+	return err
+}
+
+// Drain cordons the node and evicts every pod running on it, except mirror and
+// DaemonSet pods like kubectl does. Evictions go
+// through the eviction API so PodDisruptionBudgets are enforced by the server,
+// pods that could not be evicted are reported instead of failing the drain.
+func (n *NodeAPI) Drain(name string, opts DrainOptions) (DrainResult, error) {
+	res := DrainResult{}
+	if err := n.SetUnschedulable(name, true); err != nil {
+		return res, err
+	}
+
+	pods, err := n.Client.CoreV1().
+		Pods(metav1.NamespaceAll).
+		List(n.Context, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+		})
+	if err != nil {
+		return res, err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != name {
+			continue
+		}
+		ref := PodReference{Name: pod.Name, Namespace: pod.Namespace}
+		if isMirrorPod(pod) || (!opts.EvictDaemonSets && isDaemonSetPod(pod)) {
+			res.Skipped = append(res.Skipped, ref)
+			continue
+		}
+
+		err := n.evict(pod, opts)
+		switch {
+		case err == nil, kerrors.IsNotFound(err):
+			res.Evicted = append(res.Evicted, ref)
+		case kerrors.IsTooManyRequests(err):
+			res.Failed = append(res.Failed, DrainFailure{
+				Pod:    ref,
+				Reason: fmt.Sprintf("disruption budget violated: %s", err.Error()),
+			})
+		default:
+			res.Failed = append(res.Failed, DrainFailure{
+				Pod:    ref,
+				Reason: err.Error(),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (n *NodeAPI) evict(pod api.Pod, opts DrainOptions) error {
+	eviction := &policy.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+		DeleteOptions: &metav1.DeleteOptions{
+			GracePeriodSeconds: opts.GracePeriodSeconds,
+		},
+	}
+	return n.Client.PolicyV1().
+		Evictions(pod.Namespace).
+		Evict(n.Context, eviction)
+}
+
+func isMirrorPod(pod api.Pod) bool {
+	_, ok := pod.Annotations[mirrorPodAnnotation]
+	return ok
+}
+
+func isDaemonSetPod(pod api.Pod) bool {
+	owner := metav1.GetControllerOf(&pod)
+	return owner != nil && owner.Kind == "DaemonSet"
+}
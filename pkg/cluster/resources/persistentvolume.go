@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Only one volume source (HostPath, NFS or CSI) should be set
+type PersistentVolume struct {
+	Name          string                            `sm:"metadata.name"`
+	Labels        map[string]string                 `sm:"metadata.labels"`
+	Capacity      string                            `sm:"spec.capacity.storage"`
+	AccessModes   []api.PersistentVolumeAccessMode  `sm:"spec.accessModes"`
+	ReclaimPolicy api.PersistentVolumeReclaimPolicy `sm:"spec.persistentVolumeReclaimPolicy"`
+	StorageClass  string                            `sm:"spec.storageClassName"`
+	VolumeMode    api.PersistentVolumeMode          `sm:"spec.volumeMode"`
+	MountOptions  []string                          `sm:"spec.mountOptions"`
+	ClaimRef      *ClaimReference                   `sm:"spec.claimRef"`
+	HostPath      string                            `sm:"spec.hostPath.path"`
+	NFS           *NFSVolumeSource                  `sm:"spec.nfs"`
+	CSI           *CSIVolumeSource                  `sm:"spec.csi"`
+	Status        PersistentVolumeStatus            `sm:"->"`
+}
+
+// ClaimReference pre-binds the volume to a claim, or points to the claim it
+// was bound to when read back
+type ClaimReference struct {
+	Name      string `sm:"name"`
+	Namespace string `sm:"namespace"`
+}
+
+type NFSVolumeSource struct {
+	Server   string `sm:"server"`
+	Path     string `sm:"path"`
+	ReadOnly bool   `sm:"readOnly"`
+}
+
+type CSIVolumeSource struct {
+	Driver     string            `sm:"driver"`
+	Handle     string            `sm:"volumeHandle"`
+	FSType     string            `sm:"fsType"`
+	ReadOnly   bool              `sm:"readOnly"`
+	Attributes map[string]string `sm:"volumeAttributes"`
+}
+
+// PersistentVolumeStatus is only populated by Get and List, it's ignored on
+// Create and Update
+type PersistentVolumeStatus struct {
+	Phase  api.PersistentVolumePhase `sm:"status.phase"`
+	Reason string                    `sm:"status.reason"`
+}
+
+func (pv PersistentVolume) API() ClusterResourceAPI {
+	return &PersistentVolumeAPI{}
+}
+
+func (pv PersistentVolume) Dump(from interface{}) (interface{}, error) {
+	volume, ok := from.(PersistentVolume)
+	if !ok {
+		return nil, base.InvalidObjectError(PersistentVolume{}, from)
+	}
+	volume.Status = PersistentVolumeStatus{}
+	res := &api.PersistentVolume{}
+	err := sm.Marshal(volume, res)
+	return res, err
+}
+
+func (pv PersistentVolume) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type PersistentVolumeAPI struct {
+	base.KubeAPI
+}
+
+func (pv *PersistentVolumeAPI) Get(name string) (interface{}, error) {
+	res, err := pv.Client.CoreV1().
+		PersistentVolumes().
+		Get(pv.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (pv *PersistentVolumeAPI) Create(obj interface{}) error {
+	res := obj.(*api.PersistentVolume)
+	_, err := pv.Client.CoreV1().
+		PersistentVolumes().
+		Create(pv.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (pv *PersistentVolumeAPI) Update(obj interface{}) error {
+	res := obj.(*api.PersistentVolume)
+	_, err := pv.Client.CoreV1().
+		PersistentVolumes().
+		Update(pv.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (pv *PersistentVolumeAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := pv.Client.CoreV1().
+		PersistentVolumes().
+		List(pv.Context, pv.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (pv *PersistentVolumeAPI) Delete(name string) error {
+	return pv.Client.CoreV1().
+		PersistentVolumes().
+		Delete(pv.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PriorityClass struct {
+	Name             string               `sm:"metadata.name"`
+	Labels           map[string]string    `sm:"metadata.labels"`
+	Value            int                  `sm:"value"`
+	GlobalDefault    bool                 `sm:"globalDefault"`
+	Description      string               `sm:"description"`
+	PreemptionPolicy api.PreemptionPolicy `sm:"preemptionPolicy"`
+}
+
+func (pc PriorityClass) API() ClusterResourceAPI {
+	return &PriorityClassAPI{}
+}
+
+func (pc PriorityClass) Dump(from interface{}) (interface{}, error) {
+	res := &scheduling.PriorityClass{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (pc PriorityClass) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type PriorityClassAPI struct {
+	base.KubeAPI
+}
+
+func (pc *PriorityClassAPI) Get(name string) (interface{}, error) {
+	res, err := pc.Client.SchedulingV1().
+		PriorityClasses().
+		Get(pc.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (pc *PriorityClassAPI) Create(obj interface{}) error {
+	res := obj.(*scheduling.PriorityClass)
+	_, err := pc.Client.SchedulingV1().
+		PriorityClasses().
+		Create(pc.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (pc *PriorityClassAPI) Update(obj interface{}) error {
+	res := obj.(*scheduling.PriorityClass)
+	_, err := pc.Client.SchedulingV1().
+		PriorityClasses().
+		Update(pc.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (pc *PriorityClassAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := pc.Client.SchedulingV1().
+		PriorityClasses().
+		List(pc.Context, pc.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (pc *PriorityClassAPI) Delete(name string) error {
+	return pc.Client.SchedulingV1().
+		PriorityClasses().
+		Delete(pc.Context, name, metav1.DeleteOptions{})
+}
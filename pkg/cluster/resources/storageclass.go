@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type StorageClass struct {
+	Name           string                            `sm:"metadata.name"`
+	Labels         map[string]string                 `sm:"metadata.labels"`
+	Annotations    map[string]string                 `sm:"metadata.annotations"`
+	Provisioner    string                            `sm:"provisioner"`
+	Parameters     map[string]string                 `sm:"parameters"`
+	ReclaimPolicy  api.PersistentVolumeReclaimPolicy `sm:"reclaimPolicy"`
+	BindingMode    storage.VolumeBindingMode         `sm:"volumeBindingMode"`
+	AllowExpansion bool                              `sm:"allowVolumeExpansion"`
+	MountOptions   []string                          `sm:"mountOptions"`
+}
+
+func (sc StorageClass) API() ClusterResourceAPI {
+	return &StorageClassAPI{}
+}
+
+func (sc StorageClass) Dump(from interface{}) (interface{}, error) {
+	res := &storage.StorageClass{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (sc StorageClass) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type StorageClassAPI struct {
+	base.KubeAPI
+}
+
+func (sc *StorageClassAPI) Get(name string) (interface{}, error) {
+	res, err := sc.Client.StorageV1().
+		StorageClasses().
+		Get(sc.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (sc *StorageClassAPI) Create(obj interface{}) error {
+	res := obj.(*storage.StorageClass)
+	_, err := sc.Client.StorageV1().
+		StorageClasses().
+		Create(sc.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (sc *StorageClassAPI) Update(obj interface{}) error {
+	res := obj.(*storage.StorageClass)
+	_, err := sc.Client.StorageV1().
+		StorageClasses().
+		Update(sc.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (sc *StorageClassAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := sc.Client.StorageV1().
+		StorageClasses().
+		List(sc.Context, sc.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (sc *StorageClassAPI) Delete(name string) error {
+	return sc.Client.StorageV1().
+		StorageClasses().
+		Delete(sc.Context, name, metav1.DeleteOptions{})
+}
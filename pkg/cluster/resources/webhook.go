@@ -0,0 +1,189 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	admission "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ValidatingWebhookConfiguration struct {
+	Name        string              `sm:"metadata.name"`
+	Labels      map[string]string   `sm:"metadata.labels"`
+	Annotations map[string]string   `sm:"metadata.annotations"`
+	Webhooks    []ValidatingWebhook `sm:"webhooks"`
+}
+
+type MutatingWebhookConfiguration struct {
+	Name        string            `sm:"metadata.name"`
+	Labels      map[string]string `sm:"metadata.labels"`
+	Annotations map[string]string `sm:"metadata.annotations"`
+	Webhooks    []MutatingWebhook `sm:"webhooks"`
+}
+
+type ValidatingWebhook struct {
+	Name                    string                      `sm:"name"`
+	ClientConfig            WebhookClientConfig         `sm:"clientConfig"`
+	Rules                   []WebhookRule               `sm:"rules"`
+	FailurePolicy           admission.FailurePolicyType `sm:"failurePolicy"`
+	MatchPolicy             admission.MatchPolicyType   `sm:"matchPolicy"`
+	SideEffects             admission.SideEffectClass   `sm:"sideEffects"`
+	TimeoutSeconds          int                         `sm:"timeoutSeconds"`
+	AdmissionReviewVersions []string                    `sm:"admissionReviewVersions"`
+	NamespaceSelector       *base.LabelSelector         `sm:"namespaceSelector"`
+	ObjectSelector          *base.LabelSelector         `sm:"objectSelector"`
+}
+
+type MutatingWebhook struct {
+	Name                    string                           `sm:"name"`
+	ClientConfig            WebhookClientConfig              `sm:"clientConfig"`
+	Rules                   []WebhookRule                    `sm:"rules"`
+	FailurePolicy           admission.FailurePolicyType      `sm:"failurePolicy"`
+	MatchPolicy             admission.MatchPolicyType        `sm:"matchPolicy"`
+	SideEffects             admission.SideEffectClass        `sm:"sideEffects"`
+	TimeoutSeconds          int                              `sm:"timeoutSeconds"`
+	AdmissionReviewVersions []string                         `sm:"admissionReviewVersions"`
+	NamespaceSelector       *base.LabelSelector              `sm:"namespaceSelector"`
+	ObjectSelector          *base.LabelSelector              `sm:"objectSelector"`
+	ReinvocationPolicy      admission.ReinvocationPolicyType `sm:"reinvocationPolicy"`
+}
+
+// WebhookClientConfig points to the webhook server, either by URL or through
+// an in cluster Service
+type WebhookClientConfig struct {
+	URL      string          `sm:"url"`
+	Service  *WebhookService `sm:"service"`
+	CABundle []byte          `sm:"caBundle"`
+}
+
+type WebhookService struct {
+	Name      string `sm:"name"`
+	Namespace string `sm:"namespace"`
+	Path      string `sm:"path"`
+	Port      int    `sm:"port"`
+}
+
+type WebhookRule struct {
+	Operations  []admission.OperationType `sm:"operations"`
+	APIGroups   []string                  `sm:"apiGroups"`
+	APIVersions []string                  `sm:"apiVersions"`
+	Resources   []string                  `sm:"resources"`
+	Scope       admission.ScopeType       `sm:"scope"`
+}
+
+func (vw ValidatingWebhookConfiguration) API() ClusterResourceAPI {
+	return &ValidatingWebhookConfigurationAPI{}
+}
+
+func (vw ValidatingWebhookConfiguration) Dump(from interface{}) (interface{}, error) {
+	res := &admission.ValidatingWebhookConfiguration{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (vw ValidatingWebhookConfiguration) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+func (mw MutatingWebhookConfiguration) API() ClusterResourceAPI {
+	return &MutatingWebhookConfigurationAPI{}
+}
+
+func (mw MutatingWebhookConfiguration) Dump(from interface{}) (interface{}, error) {
+	res := &admission.MutatingWebhookConfiguration{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (mw MutatingWebhookConfiguration) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type ValidatingWebhookConfigurationAPI struct {
+	base.KubeAPI
+}
+
+func (vw *ValidatingWebhookConfigurationAPI) Get(name string) (interface{}, error) {
+	res, err := vw.Client.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		Get(vw.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (vw *ValidatingWebhookConfigurationAPI) Create(obj interface{}) error {
+	res := obj.(*admission.ValidatingWebhookConfiguration)
+	_, err := vw.Client.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		Create(vw.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (vw *ValidatingWebhookConfigurationAPI) Update(obj interface{}) error {
+	res := obj.(*admission.ValidatingWebhookConfiguration)
+	_, err := vw.Client.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		Update(vw.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (vw *ValidatingWebhookConfigurationAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := vw.Client.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		List(vw.Context, vw.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (vw *ValidatingWebhookConfigurationAPI) Delete(name string) error {
+	return vw.Client.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		Delete(vw.Context, name, metav1.DeleteOptions{})
+}
+
+type MutatingWebhookConfigurationAPI struct {
+	base.KubeAPI
+}
+
+func (mw *MutatingWebhookConfigurationAPI) Get(name string) (interface{}, error) {
+	res, err := mw.Client.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Get(mw.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (mw *MutatingWebhookConfigurationAPI) Create(obj interface{}) error {
+	res := obj.(*admission.MutatingWebhookConfiguration)
+	_, err := mw.Client.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Create(mw.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (mw *MutatingWebhookConfigurationAPI) Update(obj interface{}) error {
+	res := obj.(*admission.MutatingWebhookConfiguration)
+	_, err := mw.Client.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Update(mw.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (mw *MutatingWebhookConfigurationAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := mw.Client.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		List(mw.Context, mw.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (mw *MutatingWebhookConfigurationAPI) Delete(name string) error {
+	return mw.Client.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Delete(mw.Context, name, metav1.DeleteOptions{})
+}
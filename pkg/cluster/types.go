@@ -1,6 +1,8 @@
 package cluster
 
 import (
+	"time"
+
 	"github.com/ilexPar/simple-kube/pkg/base"
 	"github.com/ilexPar/simple-kube/pkg/cluster/resources"
 )
@@ -11,17 +13,30 @@ type ClusterResourceMethods interface {
 	API() resources.ClusterResourceAPI
 }
 
-type ClusterResourcesConstrain interface {
-	resources.Namespace
-}
-
+// ClusterResources is satisfied by any type implementing the resource
+// methods, see For
 type ClusterResources interface {
-	ClusterResourcesConstrain
 	ClusterResourceMethods
 }
 
 type QueryCluster interface {
+	// ResourceAPI and DynamicAPI are what For and Custom build actions from,
+	// wrappers around a query usually delegate them
+	ResourceAPI(ClusterResourceMethods) resources.ClusterResourceAPI
+	DynamicAPI(base.DynamicResource) resources.ClusterResourceAPI
+
 	Namespace() ClusterAction[resources.Namespace]
+	ClusterRole() ClusterAction[resources.ClusterRole]
+	ClusterRoleBinding() ClusterAction[resources.ClusterRoleBinding]
+	Node() NodeAction
+	StorageClass() ClusterAction[resources.StorageClass]
+	PersistentVolume() ClusterAction[resources.PersistentVolume]
+	PriorityClass() ClusterAction[resources.PriorityClass]
+	CustomResourceDefinition() ClusterAction[resources.CustomResourceDefinition]
+	IngressClass() ClusterAction[resources.IngressClass]
+	ValidatingWebhookConfiguration() ClusterAction[resources.ValidatingWebhookConfiguration]
+	MutatingWebhookConfiguration() ClusterAction[resources.MutatingWebhookConfiguration]
+	CertificateSigningRequest() CertificateSigningRequestAction
 }
 
 type ClusterAction[T ClusterResources] interface {
@@ -50,3 +65,33 @@ type ClusterListInterface[T ClusterResources] interface {
 type ClusterDeleteInterface[T ClusterResources] interface {
 	Run() error
 }
+
+type NodeAction interface {
+	ClusterAction[resources.Node]
+	Cordon(string) NodeScheduleInterface
+	Uncordon(string) NodeScheduleInterface
+	Drain(string, resources.DrainOptions) NodeDrainInterface
+}
+
+type NodeScheduleInterface interface {
+	Run() error
+}
+
+type NodeDrainInterface interface {
+	Run() (resources.DrainResult, error)
+}
+
+type CertificateSigningRequestAction interface {
+	ClusterAction[resources.CertificateSigningRequest]
+	Approve(name, reason, message string) CertificateSigningRequestApprovalInterface
+	Deny(name, reason, message string) CertificateSigningRequestApprovalInterface
+	WaitForCertificate(string, time.Duration) CertificateSigningRequestWaitInterface
+}
+
+type CertificateSigningRequestApprovalInterface interface {
+	Run() error
+}
+
+type CertificateSigningRequestWaitInterface interface {
+	Run() ([]byte, error)
+}
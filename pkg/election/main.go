@@ -0,0 +1,248 @@
+// Leader election on top of a Lease, modeled after
+// k8s.io/client-go/tools/leaderelection
+//
+//	elector, err := election.New(client.NamespacedQuery(namespace), election.Config{
+//		Name:     "my-controller",
+//		Identity: podName,
+//		Callbacks: election.Callbacks{
+//			OnStartedLeading: func(ctx context.Context) { controller.Run(ctx) },
+//			OnStoppedLeading: func() { log.Println("leadership lost") },
+//		},
+//	})
+//	for ctx.Err() == nil {
+//		elector.Run(ctx)
+//	}
+package election
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	"github.com/ilexPar/simple-kube/pkg/namespaced"
+	"github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+
+	coordination "k8s.io/api/coordination/v1"
+)
+
+type Elector struct {
+	leases namespaced.NamespacedAction[resources.Lease]
+	config Config
+
+	mu         sync.Mutex
+	observed   resources.Lease
+	observedAt time.Time
+}
+
+func New(query namespaced.QueryNamespace, config Config) (*Elector, error) {
+	if config.LeaseDuration == 0 {
+		config.LeaseDuration = DefaultLeaseDuration
+	}
+	if config.RenewDeadline == 0 {
+		config.RenewDeadline = DefaultRenewDeadline
+	}
+	if config.RetryPeriod == 0 {
+		config.RetryPeriod = DefaultRetryPeriod
+	}
+	if config.Clock == nil {
+		config.Clock = systemClock{}
+	}
+
+	switch {
+	case config.Name == "":
+		return nil, errors.New("lease name is required")
+	case config.Identity == "":
+		return nil, errors.New("identity is required")
+	case config.LeaseDuration <= config.RenewDeadline:
+		return nil, errors.New("lease duration must be greater than renew deadline")
+	case config.RenewDeadline <= config.RetryPeriod:
+		return nil, errors.New("renew deadline must be greater than retry period")
+	case config.Callbacks.OnStartedLeading == nil:
+		return nil, errors.New("OnStartedLeading callback is required")
+	case config.Callbacks.OnStoppedLeading == nil:
+		return nil, errors.New("OnStoppedLeading callback is required")
+	}
+
+	return &Elector{
+		leases: query.Lease(),
+		config: config,
+	}, nil
+}
+
+// Run blocks until leadership is acquired and then lost, or ctx is cancelled.
+// Callers usually run it in a loop to keep competing for the lease
+func (e *Elector) Run(ctx context.Context) {
+	if !e.acquire(ctx) {
+		return
+	}
+	leaderCtx, cancel := context.WithCancel(ctx)
+	go e.config.Callbacks.OnStartedLeading(leaderCtx)
+
+	e.renew(ctx)
+	cancel()
+	if ctx.Err() != nil && e.config.ReleaseOnCancel {
+		// best effort, the lease expires anyway
+		_ = e.release()
+	}
+	e.config.Callbacks.OnStoppedLeading()
+}
+
+// IsLeader reports if this candidate holds a lease that hasn't expired
+func (e *Elector) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.observed.Holder == e.config.Identity &&
+		!e.expired(e.config.Clock.Now())
+}
+
+// Leader returns the last observed holder of the lease
+func (e *Elector) Leader() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.observed.Holder
+}
+
+// TryAcquireOrRenew makes a single attempt to take or renew the lease, it's
+// what Run calls every retry period
+func (e *Elector) TryAcquireOrRenew() (bool, error) {
+	now := e.config.Clock.Now()
+	lease, version, err := e.get()
+	if err != nil {
+		if err.Error() != skerr.ERROR_NOT_FOUND {
+			return false, err
+		}
+		lease = resources.Lease{
+			Name:            e.config.Name,
+			Holder:          e.config.Identity,
+			DurationSeconds: int(e.config.LeaseDuration.Seconds()),
+			AcquireTime:     resources.NewTimestamp(now),
+			RenewTime:       resources.NewTimestamp(now),
+		}
+		if err = e.leases.Create(lease).Run(); err != nil {
+			return false, err
+		}
+		e.observe(lease, now)
+		return true, nil
+	}
+
+	e.observe(lease, now)
+	if lease.Holder != "" && lease.Holder != e.config.Identity && !e.expired(now) {
+		return false, nil
+	}
+
+	if lease.Holder != e.config.Identity {
+		lease.Holder = e.config.Identity
+		lease.AcquireTime = resources.NewTimestamp(now)
+		lease.Transitions++
+	}
+	lease.RenewTime = resources.NewTimestamp(now)
+	lease.DurationSeconds = int(e.config.LeaseDuration.Seconds())
+	if err = e.update(lease, version); err != nil {
+		return false, err
+	}
+	e.observe(lease, now)
+	return true, nil
+}
+
+func (e *Elector) acquire(ctx context.Context) bool {
+	for {
+		if ok, _ := e.TryAcquireOrRenew(); ok {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-e.config.Clock.After(e.config.RetryPeriod):
+		}
+	}
+}
+
+// renew returns once ctx is cancelled, another candidate takes the lease or
+// renewals keep failing for longer than the renew deadline
+func (e *Elector) renew(ctx context.Context) {
+	lastRenew := e.config.Clock.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.config.Clock.After(e.config.RetryPeriod):
+		}
+		ok, err := e.TryAcquireOrRenew()
+		now := e.config.Clock.Now()
+		switch {
+		case ok:
+			lastRenew = now
+		case err == nil:
+			return
+		case now.Sub(lastRenew) >= e.config.RenewDeadline:
+			return
+		}
+	}
+}
+
+func (e *Elector) release() error {
+	lease, version, err := e.get()
+	if err != nil {
+		return err
+	}
+	if lease.Holder != e.config.Identity {
+		return nil
+	}
+	now := e.config.Clock.Now()
+	lease.Holder = ""
+	lease.DurationSeconds = 1
+	lease.RenewTime = resources.NewTimestamp(now)
+	if err = e.update(lease, version); err != nil {
+		return err
+	}
+	e.observe(lease, now)
+	return nil
+}
+
+// get keeps the resourceVersion of the lease so updates fail if another
+// candidate changed it in between
+func (e *Elector) get() (resources.Lease, string, error) {
+	var version string
+	lease, err := e.leases.Get(e.config.Name).
+		DataHandler(func(obj interface{}) error {
+			version = obj.(*coordination.Lease).ResourceVersion
+			return nil
+		}).
+		Run()
+	return lease, version, err
+}
+
+func (e *Elector) update(lease resources.Lease, version string) error {
+	return e.leases.Update(lease).
+		DataHandler(func(obj interface{}) error {
+			obj.(*coordination.Lease).ResourceVersion = version
+			return nil
+		}).
+		Run()
+}
+
+// observe records when the lease last changed, expiration is computed from
+// the local clock to avoid depending on clock skew between candidates
+func (e *Elector) observe(lease resources.Lease, now time.Time) {
+	e.mu.Lock()
+	newLeader := lease.Holder != e.observed.Holder
+	if newLeader || !lease.RenewTime.Equal(e.observed.RenewTime.Time) {
+		e.observed = lease
+		e.observedAt = now
+	}
+	e.mu.Unlock()
+
+	if newLeader && lease.Holder != "" && e.config.Callbacks.OnNewLeader != nil {
+		e.config.Callbacks.OnNewLeader(lease.Holder)
+	}
+}
+
+func (e *Elector) expired(now time.Time) bool {
+	duration := time.Duration(e.observed.DurationSeconds) * time.Second
+	if duration == 0 {
+		duration = e.config.LeaseDuration
+	}
+	return !e.observedAt.Add(duration).After(now)
+}
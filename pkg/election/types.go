@@ -0,0 +1,54 @@
+package election
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	DefaultLeaseDuration = 15 * time.Second
+	DefaultRenewDeadline = 10 * time.Second
+	DefaultRetryPeriod   = 2 * time.Second
+)
+
+type Config struct {
+	// Name of the Lease object shared by every candidate
+	Name string
+	// Identity of this candidate, usually the pod name
+	Identity string
+	// How long non leaders wait before taking over an unrenewed lease
+	LeaseDuration time.Duration
+	// How long the leader keeps retrying a failed renewal before stepping down
+	RenewDeadline time.Duration
+	// Interval between acquire and renew attempts
+	RetryPeriod time.Duration
+	// Clear the lease holder when Run's context is cancelled, so another
+	// candidate can take over without waiting for the lease to expire
+	ReleaseOnCancel bool
+	Callbacks       Callbacks
+	// Defaults to the system clock, tests can provide their own
+	Clock Clock
+}
+
+type Callbacks struct {
+	// Runs in its own goroutine, ctx is cancelled when leadership is lost
+	OnStartedLeading func(ctx context.Context)
+	OnStoppedLeading func()
+	// Optional, called every time a different holder is observed
+	OnNewLeader func(identity string)
+}
+
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
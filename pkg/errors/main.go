@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	ERROR_NOT_FOUND = "not found"
+	ERROR_NOT_FOUND         = "not found"
+	ERROR_NO_DYNAMIC_CLIENT = "dynamic client not configured"
 )
 
 func Format(err error) error {
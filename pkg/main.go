@@ -47,6 +47,44 @@
 //			kc.Spec.JobTemplate.Spec.Template.Spec.TerminationGracePeriodSeconds = grace
 //			return nil
 //		})
+//
+// # Registering resources
+//
+// Types not shipped with the library can be plugged in by implementing the
+// resource methods (`API`, `Dump` and `Load`), where `API` returns an
+// implementation of `resources.NamespacedResourceAPI` or
+// `resources.ClusterResourceAPI`:
+//
+//	templates, err := namespaced.For[PodTemplate](namespacedQuery).
+//		List().
+//		Run()
+//
+// # Custom resources
+//
+// Resources without a typed client, like the ones defined by a
+// CustomResourceDefinition, are served through the dynamic client. Embed
+// `resources.CustomResource` in a struct with `sm` tags and get its actions
+// with the resource GroupVersionResource and kind:
+//
+//	type Certificate struct {
+//		resources.CustomResource
+//		Name       string `sm:"metadata.name"`
+//		SecretName string `sm:"spec.secretName"`
+//	}
+//
+//	client := simplekube.NewClient(ctx, clientset).WithDynamicClient(dynamicClient)
+//	certificates := namespaced.Custom[Certificate](
+//		client.NamespacedQuery(namespace),
+//		base.DynamicResource{
+//			Resource: schema.GroupVersionResource{
+//				Group:    "cert-manager.io",
+//				Version:  "v1",
+//				Resource: "certificates",
+//			},
+//			Kind: "Certificate",
+//		},
+//	)
+//	cert, err := certificates.Get("my-cert").Run()
 package simplekube
 
 import (
@@ -55,12 +93,14 @@ import (
 	"github.com/ilexPar/simple-kube/pkg/cluster"
 	"github.com/ilexPar/simple-kube/pkg/namespaced"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
 type Client struct {
-	ctx    context.Context
-	client kubernetes.Interface
+	ctx     context.Context
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
 }
 
 func NewClient(ctx context.Context, client kubernetes.Interface) *Client {
@@ -70,6 +110,13 @@ func NewClient(ctx context.Context, client kubernetes.Interface) *Client {
 	}
 }
 
+// WithDynamicClient enables custom resources, see namespaced.Custom and
+// cluster.Custom
+func (c *Client) WithDynamicClient(client dynamic.Interface) *Client {
+	c.dynamic = client
+	return c
+}
+
 func (c *Client) NamespacedQuery(
 	namespace string,
 ) namespaced.QueryNamespace {
@@ -77,12 +124,12 @@ func (c *Client) NamespacedQuery(
 		namespace,
 		c.ctx,
 		c.client,
-	)
+	).WithDynamicClient(c.dynamic)
 }
 
 func (c *Client) ClusterQuery() cluster.QueryCluster {
 	return cluster.NewQuery(
 		c.ctx,
 		c.client,
-	)
+	).WithDynamicClient(c.dynamic)
 }
@@ -0,0 +1,51 @@
+package namespaced
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/errors"
+	"github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+)
+
+type DeploymentActions struct {
+	*Action[resources.Deployment]
+	deployments *resources.DeploymentAPI
+}
+
+func (da *DeploymentActions) History(name string) DeploymentHistoryInterface {
+	return &DeploymentHistory{
+		deployments: da.deployments,
+		namespace:   da.namespace,
+		Id:          name,
+	}
+}
+
+func (da *DeploymentActions) Undo(name string, revision int64) DeploymentUndoInterface {
+	return &DeploymentUndo{
+		deployments: da.deployments,
+		namespace:   da.namespace,
+		Id:          name,
+		revision:    revision,
+	}
+}
+
+type DeploymentHistory struct {
+	deployments *resources.DeploymentAPI
+	namespace   string
+	Id          string
+}
+
+func (h *DeploymentHistory) Run() ([]resources.DeploymentRevision, error) {
+	res, err := h.deployments.History(h.Id, h.namespace)
+	return res, errors.Format(err)
+}
+
+type DeploymentUndo struct {
+	deployments *resources.DeploymentAPI
+	namespace   string
+	Id          string
+	revision    int64
+}
+
+func (u *DeploymentUndo) Run() error {
+	err := u.deployments.Undo(u.Id, u.namespace, u.revision)
+	return errors.Format(err)
+}
@@ -0,0 +1,55 @@
+package namespaced
+
+import (
+	"sort"
+
+	"github.com/ilexPar/simple-kube/pkg/errors"
+	"github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+type EventActions struct {
+	*Action[resources.Event]
+}
+
+// Involving looks up the events reported for a single object, kind is the
+// object kind as reported by the API (Deployment, Pod, ...)
+func (ea *EventActions) Involving(kind, name string) EventLookupInterface {
+	return &EventLookup{
+		*ea.Action,
+		kind,
+		name,
+	}
+}
+
+type EventLookup struct {
+	Action[resources.Event]
+	Kind string
+	Name string
+}
+
+// Run returns the object events from oldest to newest
+func (l *EventLookup) Run() ([]resources.Event, error) {
+	res := []resources.Event{}
+	l.opts.List.FieldSelector = fields.Set{
+		"involvedObject.kind": l.Kind,
+		"involvedObject.name": l.Name,
+	}.String()
+	list := &NamespacedList[resources.Event]{l.Action}
+	events, err := list.Run()
+	if err != nil {
+		return res, errors.Format(err)
+	}
+	// field selectors are applied by the API server, filter again in case the
+	// client doesn't support them
+	for _, event := range events {
+		if event.Object.Kind == l.Kind && event.Object.Name == l.Name {
+			res = append(res, event)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Time().Before(res[j].Time())
+	})
+	return res, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ilexPar/simple-kube/pkg/namespaced/resources"
 	skns "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -82,9 +83,22 @@ type Query struct {
 	namespace string
 	ctx       context.Context
 	client    kubernetes.Interface
+	dynamic   dynamic.Interface
 }
 
-func (n *Query) getResourceAPI(
+// WithDynamicClient sets the client used to serve custom resources
+func (n *Query) WithDynamicClient(client dynamic.Interface) *Query {
+	n.dynamic = client
+	return n
+}
+
+// Namespace returns the namespace targeted by the query
+func (n *Query) Namespace() string {
+	return n.namespace
+}
+
+// ResourceAPI returns the API of res configured with the query client
+func (n *Query) ResourceAPI(
 	res NamespacedResourceMethods,
 ) skns.NamespacedResourceAPI {
 	api := res.API()
@@ -92,65 +106,151 @@ func (n *Query) getResourceAPI(
 	return api
 }
 
-func (n *Query) Deployment() NamespacedAction[skns.Deployment] {
-	res := skns.Deployment{}
+// DynamicAPI returns an API serving def through the query dynamic client
+func (n *Query) DynamicAPI(
+	def base.DynamicResource,
+) skns.NamespacedResourceAPI {
+	api := &skns.CustomResourceAPI{}
+	api.Config(n.ctx, n.client)
+	api.ConfigDynamic(n.dynamic, def)
+	return api
+}
+
+// For returns the actions for any type implementing NamespacedResourceMethods,
+// so resources not shipped with the library can be plugged in
+//
+//	templates := namespaced.For[PodTemplate](client.NamespacedQuery("default"))
+func For[T NamespacedResources](query QueryNamespace) NamespacedAction[T] {
+	res := *new(T)
 	return NewAction(
-		n.namespace,
+		query.Namespace(),
 		res,
-		n.getResourceAPI(res),
+		query.ResourceAPI(res),
 	)
 }
 
-func (n *Query) Service() NamespacedAction[skns.Service] {
-	res := skns.Service{}
+// Custom returns the actions for a resource served through the dynamic client,
+// T is usually a simplified struct embedding resources.CustomResource
+func Custom[T NamespacedResources](
+	query QueryNamespace,
+	def base.DynamicResource,
+) NamespacedAction[T] {
+	res := *new(T)
 	return NewAction(
-		n.namespace,
+		query.Namespace(),
 		res,
-		n.getResourceAPI(res),
+		query.DynamicAPI(def),
 	)
 }
 
+func (n *Query) Deployment() DeploymentAction {
+	res := skns.Deployment{}
+	api := n.ResourceAPI(res)
+	return &DeploymentActions{
+		NewAction(n.namespace, res, api),
+		api.(*skns.DeploymentAPI),
+	}
+}
+
+func (n *Query) Service() ServiceAction {
+	res := skns.Service{}
+	api := n.ResourceAPI(res)
+	return &ServiceActions{
+		NewAction(n.namespace, res, api),
+		api.(*skns.ServiceAPI),
+	}
+}
+
 func (n *Query) Job() NamespacedAction[skns.Job] {
-	res := skns.Job{}
-	return NewAction(
-		n.namespace,
-		res,
-		n.getResourceAPI(res),
-	)
+	return For[skns.Job](n)
 }
 
 func (n *Query) CronJob() NamespacedAction[skns.CronJob] {
-	res := skns.CronJob{}
-	return NewAction(
-		n.namespace,
-		res,
-		n.getResourceAPI(res),
-	)
+	return For[skns.CronJob](n)
 }
 
 func (n *Query) ConfigMap() NamespacedAction[skns.ConfigMap] {
-	res := skns.ConfigMap{}
-	return NewAction(
-		n.namespace,
-		res,
-		n.getResourceAPI(res),
-	)
+	return For[skns.ConfigMap](n)
 }
 
 func (n *Query) Ingress() NamespacedAction[skns.Ingress] {
-	res := skns.Ingress{}
-	return NewAction(
-		n.namespace,
-		res,
-		n.getResourceAPI(res),
-	)
+	return For[skns.Ingress](n)
 }
 
 func (n *Query) HPA() NamespacedAction[skns.HPA] {
-	res := skns.HPA{}
-	return NewAction(
-		n.namespace,
-		res,
-		n.getResourceAPI(res),
-	)
+	return For[skns.HPA](n)
+}
+
+func (n *Query) StatefulSet() NamespacedAction[skns.StatefulSet] {
+	return For[skns.StatefulSet](n)
+}
+
+func (n *Query) DaemonSet() NamespacedAction[skns.DaemonSet] {
+	return For[skns.DaemonSet](n)
+}
+
+func (n *Query) Secret() NamespacedAction[skns.Secret] {
+	return For[skns.Secret](n)
+}
+
+func (n *Query) Pod() NamespacedAction[skns.Pod] {
+	return For[skns.Pod](n)
+}
+
+func (n *Query) PersistentVolumeClaim() NamespacedAction[skns.PersistentVolumeClaim] {
+	return For[skns.PersistentVolumeClaim](n)
+}
+
+func (n *Query) ServiceAccount() NamespacedAction[skns.ServiceAccount] {
+	return For[skns.ServiceAccount](n)
+}
+
+func (n *Query) Role() NamespacedAction[skns.Role] {
+	return For[skns.Role](n)
+}
+
+func (n *Query) RoleBinding() NamespacedAction[skns.RoleBinding] {
+	return For[skns.RoleBinding](n)
+}
+
+func (n *Query) NetworkPolicy() NamespacedAction[skns.NetworkPolicy] {
+	return For[skns.NetworkPolicy](n)
+}
+
+func (n *Query) PodDisruptionBudget() NamespacedAction[skns.PodDisruptionBudget] {
+	return For[skns.PodDisruptionBudget](n)
+}
+
+func (n *Query) ResourceQuota() ResourceQuotaAction {
+	res := skns.ResourceQuota{}
+	return &ResourceQuotaActions{
+		NewAction(
+			n.namespace,
+			res,
+			n.ResourceAPI(res),
+		),
+	}
+}
+
+func (n *Query) LimitRange() NamespacedAction[skns.LimitRange] {
+	return For[skns.LimitRange](n)
+}
+
+func (n *Query) Event() EventAction {
+	res := skns.Event{}
+	return &EventActions{
+		NewAction(
+			n.namespace,
+			res,
+			n.ResourceAPI(res),
+		),
+	}
+}
+
+func (n *Query) ReplicaSet() NamespacedAction[skns.ReplicaSet] {
+	return For[skns.ReplicaSet](n)
+}
+
+func (n *Query) Lease() NamespacedAction[skns.Lease] {
+	return For[skns.Lease](n)
 }
@@ -0,0 +1,44 @@
+package namespaced
+
+import (
+	"sort"
+
+	"github.com/ilexPar/simple-kube/pkg/errors"
+	"github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+)
+
+type ResourceQuotaActions struct {
+	*Action[resources.ResourceQuota]
+}
+
+func (qa *ResourceQuotaActions) Utilization() ResourceQuotaUtilizationInterface {
+	return &ResourceQuotaUtilization{
+		*qa.Action,
+	}
+}
+
+type ResourceQuotaUtilization struct {
+	Action[resources.ResourceQuota]
+}
+
+// Run reports the usage of every quota in the namespace, ordered by quota and
+// resource name
+func (u *ResourceQuotaUtilization) Run() ([]resources.QuotaUsage, error) {
+	res := []resources.QuotaUsage{}
+	list := &NamespacedList[resources.ResourceQuota]{u.Action}
+	quotas, err := list.Run()
+	if err != nil {
+		return res, errors.Format(err)
+	}
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotas[i].Name < quotas[j].Name
+	})
+	for _, quota := range quotas {
+		usage, err := quota.Utilization()
+		if err != nil {
+			return res, err
+		}
+		res = append(res, usage...)
+	}
+	return res, nil
+}
@@ -15,6 +15,9 @@ type CronJob struct {
 	Behaviour      CronJobBehaviour  `sm:"->"`
 	ServiceAccount string            `sm:"spec.jobTemplate.spec.template.spec.serviceAccountName"`
 	Containers     []Container       `sm:"spec.jobTemplate.spec.template.spec.containers"`
+	InitContainers []Container       `sm:"spec.jobTemplate.spec.template.spec.initContainers"`
+	Scheduling     PodScheduling     `sm:"spec.jobTemplate.spec.template.spec"`
+	Volumes        []Volume          `sm:"spec.jobTemplate.spec.template.spec.volumes"`
 	Labels         map[string]string `sm:"metadata.labels"`
 	NodeSelector   map[string]string `sm:"spec.jobTemplate.spec.template.spec.nodeSelector"`
 	TemplateLabels map[string]string `sm:"spec.jobTemplate.spec.template.metadata.labels"`
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+)
+
+// CustomResource is meant to be embedded in a simplified struct to serve it
+// through the dynamic client, see namespaced.Custom
+//
+//	type Certificate struct {
+//		resources.CustomResource
+//		Name       string `sm:"metadata.name"`
+//		SecretName string `sm:"spec.secretName"`
+//	}
+type CustomResource struct{}
+
+func (cr CustomResource) API() NamespacedResourceAPI {
+	return &CustomResourceAPI{}
+}
+
+func (cr CustomResource) Dump(from interface{}) (interface{}, error) {
+	return base.DumpUnstructured(from)
+}
+
+func (cr CustomResource) Load(from, into interface{}) error {
+	return base.LoadUnstructured(from, into)
+}
+
+type CustomResourceAPI struct {
+	base.DynamicAPI
+}
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type DaemonSet struct {
+	Name            string            `sm:"metadata.name"`
+	ServiceAccount  string            `sm:"spec.template.spec.serviceAccountName"`
+	Containers      []Container       `sm:"spec.template.spec.containers"`
+	InitContainers  []Container       `sm:"spec.template.spec.initContainers"`
+	Scheduling      PodScheduling     `sm:"spec.template.spec"`
+	Volumes         []Volume          `sm:"spec.template.spec.volumes"`
+	Labels          map[string]string `sm:"metadata.labels"`
+	TemplateLabels  map[string]string `sm:"spec.template.metadata.labels"`
+	ServiceSelector map[string]string `sm:"spec.selector.matchLabels"`
+	NodeSelector    map[string]string `sm:"spec.template.spec.nodeSelector"`
+	Strategy        DaemonSetStrategy `sm:"spec.updateStrategy"`
+}
+
+// Leave MaxUnavailable and MaxSurge nil to use the server defaults, set them to
+// zero explicitly for surge rollouts (maxSurge: 1, maxUnavailable: 0)
+type DaemonSetStrategy struct {
+	Type           apps.DaemonSetUpdateStrategyType `sm:"type"`
+	MaxUnavailable *intstr.IntOrString              `sm:"rollingUpdate.maxUnavailable"`
+	MaxSurge       *intstr.IntOrString              `sm:"rollingUpdate.maxSurge"`
+}
+
+func (ds DaemonSet) API() NamespacedResourceAPI {
+	return &DaemonSetAPI{}
+}
+
+func (ds DaemonSet) Dump(from interface{}) (interface{}, error) {
+	res := &apps.DaemonSet{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (ds DaemonSet) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type DaemonSetAPI struct {
+	base.KubeAPI
+}
+
+func (ds *DaemonSetAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := ds.Client.AppsV1().
+		DaemonSets(namespace).
+		Get(ds.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (ds *DaemonSetAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*apps.DaemonSet)
+	_, err := ds.Client.AppsV1().
+		DaemonSets(namespace).
+		Create(ds.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (ds *DaemonSetAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*apps.DaemonSet)
+	_, err := ds.Client.AppsV1().
+		DaemonSets(namespace).
+		Update(ds.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (ds *DaemonSetAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := ds.Client.AppsV1().
+		DaemonSets(namespace).
+		List(ds.Context, ds.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (ds *DaemonSetAPI) Delete(name, namespace string) error {
+	return ds.Client.AppsV1().
+		DaemonSets(namespace).
+		Delete(ds.Context, name, metav1.DeleteOptions{})
+}
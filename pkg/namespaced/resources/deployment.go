@@ -1,6 +1,10 @@
 package resources
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+
 	"github.com/ilexPar/simple-kube/pkg/base"
 
 	sm "github.com/ilexPar/struct-marshal/pkg"
@@ -8,16 +12,33 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
 type Deployment struct {
 	Name            string            `sm:"metadata.name"`
 	ServiceAccount  string            `sm:"spec.template.spec.serviceAccountName"`
 	Containers      []Container       `sm:"spec.template.spec.containers"`
+	InitContainers  []Container       `sm:"spec.template.spec.initContainers"`
+	Scheduling      PodScheduling     `sm:"spec.template.spec"`
+	Volumes         []Volume          `sm:"spec.template.spec.volumes"`
 	Labels          map[string]string `sm:"metadata.labels"`
 	TemplateLabels  map[string]string `sm:"spec.template.metadata.labels"`
 	ServiceSelector map[string]string `sm:"spec.selector.matchLabels"`
 	NodeSelector    map[string]string `sm:"spec.template.spec.nodeSelector"`
 }
 
+// DeploymentRevision describes one of the ReplicaSets kept by a Deployment
+type DeploymentRevision struct {
+	Revision    int64
+	ReplicaSet  string
+	Images      []string
+	ChangeCause string
+	Created     Timestamp
+}
+
 func (d Deployment) API() NamespacedResourceAPI {
 	return &DeploymentAPI{}
 }
@@ -75,3 +96,100 @@ func (d *DeploymentAPI) Delete(name, namespace string) error {
 		Deployments(namespace).
 		Delete(d.Context, name, metav1.DeleteOptions{})
 }
+
+// History returns the deployment revisions from oldest to newest
+func (d *DeploymentAPI) History(name, namespace string) ([]DeploymentRevision, error) {
+	res := []DeploymentRevision{}
+	deployment, err := d.Client.AppsV1().
+		Deployments(namespace).
+		Get(d.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return res, err
+	}
+	sets, err := d.replicaSets(deployment)
+	if err != nil {
+		return res, err
+	}
+	for _, set := range sets {
+		images := []string{}
+		for _, container := range set.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		res = append(res, DeploymentRevision{
+			Revision:    revisionOf(&set),
+			ReplicaSet:  set.Name,
+			Images:      images,
+			ChangeCause: set.Annotations[changeCauseAnnotation],
+			Created:     NewTimestamp(set.CreationTimestamp.Time),
+		})
+	}
+	return res, nil
+}
+
+// Undo restores the pod template of the given revision, 0 rolls back to the
+// revision previous to the current one
+func (d *DeploymentAPI) Undo(name, namespace string, revision int64) error {
+	deployment, err := d.Client.AppsV1().
+		Deployments(namespace).
+		Get(d.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	sets, err := d.replicaSets(deployment)
+	if err != nil {
+		return err
+	}
+	if revision == 0 {
+		if len(sets) < 2 {
+			return fmt.Errorf("no previous revision found for deployment %s", name)
+		}
+		revision = revisionOf(&sets[len(sets)-2])
+	}
+	var target *apps.ReplicaSet
+	for i := range sets {
+		if revisionOf(&sets[i]) == revision {
+			target = &sets[i]
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("revision %d not found for deployment %s", revision, name)
+	}
+
+	template := target.Spec.Template.DeepCopy()
+	delete(template.Labels, apps.DefaultDeploymentUniqueLabelKey)
+	deployment.Spec.Template = *template
+	_, err = d.Client.AppsV1().
+		Deployments(namespace).
+		Update(d.Context, deployment, metav1.UpdateOptions{})
+	return err
+}
+
+// replicaSets returns the ReplicaSets controlled by the deployment sorted by
+// revision
+func (d *DeploymentAPI) replicaSets(deployment *apps.Deployment) ([]apps.ReplicaSet, error) {
+	res := []apps.ReplicaSet{}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return res, err
+	}
+	list, err := d.Client.AppsV1().
+		ReplicaSets(deployment.Namespace).
+		List(d.Context, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return res, err
+	}
+	for _, set := range list.Items {
+		if metav1.IsControlledBy(&set, deployment) {
+			res = append(res, set)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return revisionOf(&res[i]) < revisionOf(&res[j])
+	})
+	return res, nil
+}
+
+func revisionOf(set *apps.ReplicaSet) int64 {
+	revision, _ := strconv.ParseInt(set.Annotations[revisionAnnotation], 10, 64)
+	return revision
+}
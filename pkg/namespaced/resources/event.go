@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Event struct {
+	Name           string          `sm:"metadata.name"`
+	Type           string          `sm:"type"`
+	Reason         string          `sm:"reason"`
+	Message        string          `sm:"message"`
+	Object         ObjectReference `sm:"involvedObject"`
+	Source         string          `sm:"source.component"`
+	Count          int             `sm:"count"`
+	FirstTimestamp Timestamp       `sm:"firstTimestamp"`
+	LastTimestamp  Timestamp       `sm:"lastTimestamp"`
+	EventTime      Timestamp       `sm:"eventTime"`
+}
+
+type ObjectReference struct {
+	Kind      string `sm:"kind"`
+	Name      string `sm:"name"`
+	Namespace string `sm:"namespace"`
+	FieldPath string `sm:"fieldPath"`
+}
+
+// Time returns when the event was last seen, events reported through the
+// events.k8s.io API only carry EventTime
+func (e Event) Time() time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	}
+	return e.FirstTimestamp.Time
+}
+
+func (e Event) API() NamespacedResourceAPI {
+	return &EventAPI{}
+}
+
+func (e Event) Dump(from interface{}) (interface{}, error) {
+	res := &api.Event{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (e Event) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type EventAPI struct {
+	base.KubeAPI
+}
+
+func (e *EventAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := e.Client.CoreV1().
+		Events(namespace).
+		Get(e.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (e *EventAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.Event)
+	_, err := e.Client.CoreV1().
+		Events(namespace).
+		Create(e.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (e *EventAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.Event)
+	_, err := e.Client.CoreV1().
+		Events(namespace).
+		Update(e.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (e *EventAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := e.Client.CoreV1().
+		Events(namespace).
+		List(e.Context, e.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (e *EventAPI) Delete(name, namespace string) error {
+	return e.Client.CoreV1().
+		Events(namespace).
+		Delete(e.Context, name, metav1.DeleteOptions{})
+}
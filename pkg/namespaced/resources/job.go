@@ -14,6 +14,9 @@ type Job struct {
 	ServiceAccount string            `sm:"spec.template.spec.serviceAccountName"`
 	Behaviour      JobBehaviour      `sm:"->"`
 	Containers     []Container       `sm:"spec.template.spec.containers"`
+	InitContainers []Container       `sm:"spec.template.spec.initContainers"`
+	Scheduling     PodScheduling     `sm:"spec.template.spec"`
+	Volumes        []Volume          `sm:"spec.template.spec.volumes"`
 	Labels         map[string]string `sm:"metadata.labels"`
 	NodeSelector   map[string]string `sm:"spec.template.spec.nodeSelector"`
 	TemplateLabels map[string]string `sm:"spec.template.metadata.labels"`
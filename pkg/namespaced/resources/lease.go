@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	coordination "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Lease struct {
+	Name            string            `sm:"metadata.name"`
+	Labels          map[string]string `sm:"metadata.labels"`
+	Holder          string            `sm:"spec.holderIdentity"`
+	DurationSeconds int               `sm:"spec.leaseDurationSeconds"`
+	AcquireTime     Timestamp         `sm:"spec.acquireTime"`
+	RenewTime       Timestamp         `sm:"spec.renewTime"`
+	Transitions     int               `sm:"spec.leaseTransitions"`
+}
+
+func (l Lease) API() NamespacedResourceAPI {
+	return &LeaseAPI{}
+}
+
+func (l Lease) Dump(from interface{}) (interface{}, error) {
+	res := &coordination.Lease{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (l Lease) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type LeaseAPI struct {
+	base.KubeAPI
+}
+
+func (l *LeaseAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := l.Client.CoordinationV1().
+		Leases(namespace).
+		Get(l.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (l *LeaseAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*coordination.Lease)
+	_, err := l.Client.CoordinationV1().
+		Leases(namespace).
+		Create(l.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (l *LeaseAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*coordination.Lease)
+	_, err := l.Client.CoordinationV1().
+		Leases(namespace).
+		Update(l.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (l *LeaseAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := l.Client.CoordinationV1().
+		Leases(namespace).
+		List(l.Context, l.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (l *LeaseAPI) Delete(name, namespace string) error {
+	return l.Client.CoordinationV1().
+		Leases(namespace).
+		Delete(l.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type LimitRange struct {
+	Name   string            `sm:"metadata.name"`
+	Labels map[string]string `sm:"metadata.labels"`
+	Limits []LimitRangeItem  `sm:"spec.limits"`
+}
+
+// Values are keyed by resource name (cpu, memory, ...), Default and
+// DefaultRequest only apply to the Container type
+type LimitRangeItem struct {
+	Type           api.LimitType     `sm:"type"`
+	Default        map[string]string `sm:"default"`
+	DefaultRequest map[string]string `sm:"defaultRequest"`
+	Min            map[string]string `sm:"min"`
+	Max            map[string]string `sm:"max"`
+}
+
+func (lr LimitRange) API() NamespacedResourceAPI {
+	return &LimitRangeAPI{}
+}
+
+func (lr LimitRange) Dump(from interface{}) (interface{}, error) {
+	res := &api.LimitRange{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (lr LimitRange) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type LimitRangeAPI struct {
+	base.KubeAPI
+}
+
+func (lr *LimitRangeAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := lr.Client.CoreV1().
+		LimitRanges(namespace).
+		Get(lr.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (lr *LimitRangeAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.LimitRange)
+	_, err := lr.Client.CoreV1().
+		LimitRanges(namespace).
+		Create(lr.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (lr *LimitRangeAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.LimitRange)
+	_, err := lr.Client.CoreV1().
+		LimitRanges(namespace).
+		Update(lr.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (lr *LimitRangeAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := lr.Client.CoreV1().
+		LimitRanges(namespace).
+		List(lr.Context, lr.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (lr *LimitRangeAPI) Delete(name, namespace string) error {
+	return lr.Client.CoreV1().
+		LimitRanges(namespace).
+		Delete(lr.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	net "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type NetworkPolicy struct {
+	Name        string                     `sm:"metadata.name"`
+	Labels      map[string]string          `sm:"metadata.labels"`
+	PodSelector LabelSelector              `sm:"spec.podSelector"`
+	PolicyTypes []net.PolicyType           `sm:"spec.policyTypes"`
+	Ingress     []NetworkPolicyIngressRule `sm:"spec.ingress"`
+	Egress      []NetworkPolicyEgressRule  `sm:"spec.egress"`
+}
+
+type NetworkPolicyIngressRule struct {
+	From  []NetworkPolicyPeer `sm:"from"`
+	Ports []NetworkPolicyPort `sm:"ports"`
+}
+
+type NetworkPolicyEgressRule struct {
+	To    []NetworkPolicyPeer `sm:"to"`
+	Ports []NetworkPolicyPort `sm:"ports"`
+}
+
+// Either set pod and/or namespace selectors, or a CIDR with optional exceptions
+type NetworkPolicyPeer struct {
+	PodSelector       *LabelSelector `sm:"podSelector"`
+	NamespaceSelector *LabelSelector `sm:"namespaceSelector"`
+	CIDR              string         `sm:"ipBlock.cidr"`
+	Except            []string       `sm:"ipBlock.except"`
+}
+
+type NetworkPolicyPort struct {
+	Protocol api.Protocol       `sm:"protocol"`
+	Port     intstr.IntOrString `sm:"port"`
+	EndPort  int                `sm:"endPort"`
+}
+
+func (np NetworkPolicy) API() NamespacedResourceAPI {
+	return &NetworkPolicyAPI{}
+}
+
+func (np NetworkPolicy) Dump(from interface{}) (interface{}, error) {
+	res := &net.NetworkPolicy{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (np NetworkPolicy) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type NetworkPolicyAPI struct {
+	base.KubeAPI
+}
+
+func (np *NetworkPolicyAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := np.Client.NetworkingV1().
+		NetworkPolicies(namespace).
+		Get(np.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (np *NetworkPolicyAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*net.NetworkPolicy)
+	_, err := np.Client.NetworkingV1().
+		NetworkPolicies(namespace).
+		Create(np.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (np *NetworkPolicyAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*net.NetworkPolicy)
+	_, err := np.Client.NetworkingV1().
+		NetworkPolicies(namespace).
+		Update(np.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (np *NetworkPolicyAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := np.Client.NetworkingV1().
+		NetworkPolicies(namespace).
+		List(np.Context, np.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (np *NetworkPolicyAPI) Delete(name, namespace string) error {
+	return np.Client.NetworkingV1().
+		NetworkPolicies(namespace).
+		Delete(np.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Only one of MinAvailable and MaxUnavailable can be set, both accept either
+// a number of pods or a percentage
+type PodDisruptionBudget struct {
+	Name                       string                                `sm:"metadata.name"`
+	Labels                     map[string]string                     `sm:"metadata.labels"`
+	Selector                   LabelSelector                         `sm:"spec.selector"`
+	MinAvailable               *intstr.IntOrString                   `sm:"spec.minAvailable"`
+	MaxUnavailable             *intstr.IntOrString                   `sm:"spec.maxUnavailable"`
+	UnhealthyPodEvictionPolicy policy.UnhealthyPodEvictionPolicyType `sm:"spec.unhealthyPodEvictionPolicy"`
+	Status                     PodDisruptionBudgetStatus             `sm:"->"`
+}
+
+// PodDisruptionBudgetStatus is only populated by Get and List, it's ignored
+// on Create and Update
+type PodDisruptionBudgetStatus struct {
+	CurrentHealthy     int `sm:"status.currentHealthy"`
+	DesiredHealthy     int `sm:"status.desiredHealthy"`
+	ExpectedPods       int `sm:"status.expectedPods"`
+	DisruptionsAllowed int `sm:"status.disruptionsAllowed"`
+}
+
+func (pdb PodDisruptionBudget) API() NamespacedResourceAPI {
+	return &PodDisruptionBudgetAPI{}
+}
+
+func (pdb PodDisruptionBudget) Dump(from interface{}) (interface{}, error) {
+	budget, ok := from.(PodDisruptionBudget)
+	if !ok {
+		return nil, base.InvalidObjectError(PodDisruptionBudget{}, from)
+	}
+	budget.Status = PodDisruptionBudgetStatus{}
+	res := &policy.PodDisruptionBudget{}
+	err := sm.Marshal(budget, res)
+	return res, err
+}
+
+func (pdb PodDisruptionBudget) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type PodDisruptionBudgetAPI struct {
+	base.KubeAPI
+}
+
+func (pdb *PodDisruptionBudgetAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := pdb.Client.PolicyV1().
+		PodDisruptionBudgets(namespace).
+		Get(pdb.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (pdb *PodDisruptionBudgetAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*policy.PodDisruptionBudget)
+	_, err := pdb.Client.PolicyV1().
+		PodDisruptionBudgets(namespace).
+		Create(pdb.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (pdb *PodDisruptionBudgetAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*policy.PodDisruptionBudget)
+	_, err := pdb.Client.PolicyV1().
+		PodDisruptionBudgets(namespace).
+		Update(pdb.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (pdb *PodDisruptionBudgetAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := pdb.Client.PolicyV1().
+		PodDisruptionBudgets(namespace).
+		List(pdb.Context, pdb.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (pdb *PodDisruptionBudgetAPI) Delete(name, namespace string) error {
+	return pdb.Client.PolicyV1().
+		PodDisruptionBudgets(namespace).
+		Delete(pdb.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Pod struct {
+	Name           string            `sm:"metadata.name"`
+	ServiceAccount string            `sm:"spec.serviceAccountName"`
+	Containers     []Container       `sm:"spec.containers"`
+	InitContainers []Container       `sm:"spec.initContainers"`
+	Scheduling     PodScheduling     `sm:"spec"`
+	Volumes        []Volume          `sm:"spec.volumes"`
+	Labels         map[string]string `sm:"metadata.labels"`
+	NodeSelector   map[string]string `sm:"spec.nodeSelector"`
+	// Node is set by the scheduler, leave it empty unless binding the pod by hand
+	Node   string    `sm:"spec.nodeName"`
+	Status PodStatus `sm:"->"`
+}
+
+// PodStatus is only populated by Get and List, it's cleared on Create and Update
+type PodStatus struct {
+	Phase      api.PodPhase      `sm:"status.phase"`
+	IP         string            `sm:"status.podIP"`
+	Containers []ContainerStatus `sm:"status.containerStatuses"`
+}
+
+type ContainerStatus struct {
+	Name                  string `sm:"name"`
+	Ready                 bool   `sm:"ready"`
+	RestartCount          int    `sm:"restartCount"`
+	LastTerminationReason string `sm:"lastState.terminated.reason"`
+}
+
+func (p Pod) API() NamespacedResourceAPI {
+	return &PodAPI{}
+}
+
+func (p Pod) Dump(from interface{}) (interface{}, error) {
+	pod, ok := from.(Pod)
+	if !ok {
+		return nil, base.InvalidObjectError(Pod{}, from)
+	}
+	pod.Status = PodStatus{}
+	res := &api.Pod{}
+	err := sm.Marshal(pod, res)
+	return res, err
+}
+
+func (p Pod) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type PodAPI struct {
+	base.KubeAPI
+}
+
+func (p *PodAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := p.Client.CoreV1().
+		Pods(namespace).
+		Get(p.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (p *PodAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.Pod)
+	_, err := p.Client.CoreV1().
+		Pods(namespace).
+		Create(p.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (p *PodAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.Pod)
+	_, err := p.Client.CoreV1().
+		Pods(namespace).
+		Update(p.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (p *PodAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := p.Client.CoreV1().
+		Pods(namespace).
+		List(p.Context, p.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (p *PodAPI) Delete(name, namespace string) error {
+	return p.Client.CoreV1().
+		Pods(namespace).
+		Delete(p.Context, name, metav1.DeleteOptions{})
+}
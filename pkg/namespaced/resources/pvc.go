@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PersistentVolumeClaim struct {
+	Name         string                           `sm:"metadata.name"`
+	Labels       map[string]string                `sm:"metadata.labels"`
+	StorageClass string                           `sm:"spec.storageClassName"`
+	AccessModes  []api.PersistentVolumeAccessMode `sm:"spec.accessModes"`
+	Size         string                           `sm:"spec.resources.requests.storage"`
+	VolumeMode   api.PersistentVolumeMode         `sm:"spec.volumeMode"`
+	// Volume is set once the claim is bound, fill it on Create to pre-bind the
+	// claim to a specific volume
+	Volume string                      `sm:"spec.volumeName"`
+	Status PersistentVolumeClaimStatus `sm:"->"`
+}
+
+// PersistentVolumeClaimStatus is only populated by Get and List, it's cleared
+// on Create and Update
+type PersistentVolumeClaimStatus struct {
+	Phase api.PersistentVolumeClaimPhase `sm:"status.phase"`
+}
+
+func (pvc PersistentVolumeClaim) API() NamespacedResourceAPI {
+	return &PersistentVolumeClaimAPI{}
+}
+
+func (pvc PersistentVolumeClaim) Dump(from interface{}) (interface{}, error) {
+	claim, ok := from.(PersistentVolumeClaim)
+	if !ok {
+		return nil, base.InvalidObjectError(PersistentVolumeClaim{}, from)
+	}
+	claim.Status = PersistentVolumeClaimStatus{}
+	res := &api.PersistentVolumeClaim{}
+	err := sm.Marshal(claim, res)
+	return res, err
+}
+
+func (pvc PersistentVolumeClaim) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type PersistentVolumeClaimAPI struct {
+	base.KubeAPI
+}
+
+func (pvc *PersistentVolumeClaimAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := pvc.Client.CoreV1().
+		PersistentVolumeClaims(namespace).
+		Get(pvc.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (pvc *PersistentVolumeClaimAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.PersistentVolumeClaim)
+	_, err := pvc.Client.CoreV1().
+		PersistentVolumeClaims(namespace).
+		Create(pvc.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (pvc *PersistentVolumeClaimAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.PersistentVolumeClaim)
+	_, err := pvc.Client.CoreV1().
+		PersistentVolumeClaims(namespace).
+		Update(pvc.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (pvc *PersistentVolumeClaimAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := pvc.Client.CoreV1().
+		PersistentVolumeClaims(namespace).
+		List(pvc.Context, pvc.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (pvc *PersistentVolumeClaimAPI) Delete(name, namespace string) error {
+	return pvc.Client.CoreV1().
+		PersistentVolumeClaims(namespace).
+		Delete(pvc.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicaSets are usually managed by a Deployment, see DeploymentAPI.History
+// for the revision they hold
+type ReplicaSet struct {
+	Name            string            `sm:"metadata.name"`
+	Labels          map[string]string `sm:"metadata.labels"`
+	Annotations     map[string]string `sm:"metadata.annotations"`
+	Replicas        int               `sm:"spec.replicas"`
+	Containers      []Container       `sm:"spec.template.spec.containers"`
+	InitContainers  []Container       `sm:"spec.template.spec.initContainers"`
+	Scheduling      PodScheduling     `sm:"spec.template.spec"`
+	TemplateLabels  map[string]string `sm:"spec.template.metadata.labels"`
+	ServiceSelector map[string]string `sm:"spec.selector.matchLabels"`
+	Status          ReplicaSetStatus  `sm:"->"`
+}
+
+// ReplicaSetStatus is only populated by Get and List, it's ignored on Create
+// and Update
+type ReplicaSetStatus struct {
+	Created           Timestamp `sm:"metadata.creationTimestamp"`
+	Replicas          int       `sm:"status.replicas"`
+	ReadyReplicas     int       `sm:"status.readyReplicas"`
+	AvailableReplicas int       `sm:"status.availableReplicas"`
+}
+
+func (rs ReplicaSet) API() NamespacedResourceAPI {
+	return &ReplicaSetAPI{}
+}
+
+func (rs ReplicaSet) Dump(from interface{}) (interface{}, error) {
+	set, ok := from.(ReplicaSet)
+	if !ok {
+		return nil, base.InvalidObjectError(ReplicaSet{}, from)
+	}
+	set.Status = ReplicaSetStatus{}
+	res := &apps.ReplicaSet{}
+	err := sm.Marshal(set, res)
+	return res, err
+}
+
+func (rs ReplicaSet) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type ReplicaSetAPI struct {
+	base.KubeAPI
+}
+
+func (rs *ReplicaSetAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := rs.Client.AppsV1().
+		ReplicaSets(namespace).
+		Get(rs.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (rs *ReplicaSetAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*apps.ReplicaSet)
+	_, err := rs.Client.AppsV1().
+		ReplicaSets(namespace).
+		Create(rs.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (rs *ReplicaSetAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*apps.ReplicaSet)
+	_, err := rs.Client.AppsV1().
+		ReplicaSets(namespace).
+		Update(rs.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (rs *ReplicaSetAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := rs.Client.AppsV1().
+		ReplicaSets(namespace).
+		List(rs.Context, rs.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (rs *ReplicaSetAPI) Delete(name, namespace string) error {
+	return rs.Client.AppsV1().
+		ReplicaSets(namespace).
+		Delete(rs.Context, name, metav1.DeleteOptions{})
+}
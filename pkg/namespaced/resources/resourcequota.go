@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ResourceQuota struct {
+	Name   string                   `sm:"metadata.name"`
+	Labels map[string]string        `sm:"metadata.labels"`
+	Hard   map[string]string        `sm:"spec.hard"`
+	Scopes []api.ResourceQuotaScope `sm:"spec.scopes"`
+	Status ResourceQuotaStatus      `sm:"->"`
+}
+
+// ResourceQuotaStatus is only populated by Get and List, it's ignored on
+// Create and Update
+type ResourceQuotaStatus struct {
+	Hard map[string]string `sm:"status.hard"`
+	Used map[string]string `sm:"status.used"`
+}
+
+type QuotaUsage struct {
+	Quota    string
+	Resource string
+	Used     string
+	Hard     string
+	// Percentage of the hard limit already consumed, a zero hard limit is
+	// always reported as 100 since nothing else can be admitted
+	Percent float64
+}
+
+// Utilization compares the used values reported by the quota status against
+// its hard limits, sorted by resource name
+func (rq ResourceQuota) Utilization() ([]QuotaUsage, error) {
+	res := []QuotaUsage{}
+	for name, hardValue := range rq.Hard {
+		usedValue, ok := rq.Status.Used[name]
+		if !ok {
+			usedValue = "0"
+		}
+		hard, err := resource.ParseQuantity(hardValue)
+		if err != nil {
+			return res, fmt.Errorf("quota %s: invalid hard value for %s: %w", rq.Name, name, err)
+		}
+		used, err := resource.ParseQuantity(usedValue)
+		if err != nil {
+			return res, fmt.Errorf("quota %s: invalid used value for %s: %w", rq.Name, name, err)
+		}
+		percent := float64(100)
+		if !hard.IsZero() {
+			percent = float64(used.MilliValue()) / float64(hard.MilliValue()) * 100
+		}
+		res = append(res, QuotaUsage{
+			Quota:    rq.Name,
+			Resource: name,
+			Used:     usedValue,
+			Hard:     hardValue,
+			Percent:  percent,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Resource < res[j].Resource
+	})
+	return res, nil
+}
+
+func (rq ResourceQuota) API() NamespacedResourceAPI {
+	return &ResourceQuotaAPI{}
+}
+
+func (rq ResourceQuota) Dump(from interface{}) (interface{}, error) {
+	quota, ok := from.(ResourceQuota)
+	if !ok {
+		return nil, base.InvalidObjectError(ResourceQuota{}, from)
+	}
+	quota.Status = ResourceQuotaStatus{}
+	res := &api.ResourceQuota{}
+	err := sm.Marshal(quota, res)
+	return res, err
+}
+
+func (rq ResourceQuota) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type ResourceQuotaAPI struct {
+	base.KubeAPI
+}
+
+func (rq *ResourceQuotaAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := rq.Client.CoreV1().
+		ResourceQuotas(namespace).
+		Get(rq.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (rq *ResourceQuotaAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.ResourceQuota)
+	_, err := rq.Client.CoreV1().
+		ResourceQuotas(namespace).
+		Create(rq.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (rq *ResourceQuotaAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.ResourceQuota)
+	_, err := rq.Client.CoreV1().
+		ResourceQuotas(namespace).
+		Update(rq.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (rq *ResourceQuotaAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := rq.Client.CoreV1().
+		ResourceQuotas(namespace).
+		List(rq.Context, rq.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (rq *ResourceQuotaAPI) Delete(name, namespace string) error {
+	return rq.Client.CoreV1().
+		ResourceQuotas(namespace).
+		Delete(rq.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Role struct {
+	Name   string            `sm:"metadata.name"`
+	Labels map[string]string `sm:"metadata.labels"`
+	Rules  []PolicyRule      `sm:"rules"`
+}
+
+type PolicyRule = base.PolicyRule
+
+func (r Role) API() NamespacedResourceAPI {
+	return &RoleAPI{}
+}
+
+func (r Role) Dump(from interface{}) (interface{}, error) {
+	res := &rbac.Role{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (r Role) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type RoleAPI struct {
+	base.KubeAPI
+}
+
+func (r *RoleAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := r.Client.RbacV1().
+		Roles(namespace).
+		Get(r.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (r *RoleAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*rbac.Role)
+	_, err := r.Client.RbacV1().
+		Roles(namespace).
+		Create(r.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (r *RoleAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*rbac.Role)
+	_, err := r.Client.RbacV1().
+		Roles(namespace).
+		Update(r.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *RoleAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := r.Client.RbacV1().
+		Roles(namespace).
+		List(r.Context, r.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (r *RoleAPI) Delete(name, namespace string) error {
+	return r.Client.RbacV1().
+		Roles(namespace).
+		Delete(r.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RoleBinding struct {
+	Name     string            `sm:"metadata.name"`
+	Labels   map[string]string `sm:"metadata.labels"`
+	RoleRef  RoleRef           `sm:"roleRef"`
+	Subjects []Subject         `sm:"subjects"`
+}
+
+type RoleRef = base.RoleRef
+
+type Subject = base.Subject
+
+func (rb RoleBinding) API() NamespacedResourceAPI {
+	return &RoleBindingAPI{}
+}
+
+func (rb RoleBinding) Dump(from interface{}) (interface{}, error) {
+	res := &rbac.RoleBinding{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (rb RoleBinding) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type RoleBindingAPI struct {
+	base.KubeAPI
+}
+
+func (rb *RoleBindingAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := rb.Client.RbacV1().
+		RoleBindings(namespace).
+		Get(rb.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (rb *RoleBindingAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*rbac.RoleBinding)
+	_, err := rb.Client.RbacV1().
+		RoleBindings(namespace).
+		Create(rb.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (rb *RoleBindingAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*rbac.RoleBinding)
+	_, err := rb.Client.RbacV1().
+		RoleBindings(namespace).
+		Update(rb.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (rb *RoleBindingAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := rb.Client.RbacV1().
+		RoleBindings(namespace).
+		List(rb.Context, rb.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (rb *RoleBindingAPI) Delete(name, namespace string) error {
+	return rb.Client.RbacV1().
+		RoleBindings(namespace).
+		Delete(rb.Context, name, metav1.DeleteOptions{})
+}
@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const redactedValue = "<redacted>"
+
+type Secret struct {
+	Name   string            `sm:"metadata.name"`
+	Type   api.SecretType    `sm:"type"`
+	Labels map[string]string `sm:"metadata.labels"`
+	Data   SecretData        `sm:"data"`
+}
+
+// SecretData holds secret values as raw bytes. Its printed representation
+// only ever shows the keys, so values never leak through logs or debug output.
+type SecretData map[string][]byte
+
+func (d SecretData) String() string {
+	keys := make([]string, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, 0, len(keys))
+	for _, k := range keys {
+		entries = append(entries, fmt.Sprintf("%s:%s", k, redactedValue))
+	}
+	return fmt.Sprintf("map[%s]", strings.Join(entries, " "))
+}
+
+func (d SecretData) GoString() string {
+	return d.String()
+}
+
+// Format makes every fmt verb go through String, including %#v and %x.
+func (d SecretData) Format(f fmt.State, _ rune) {
+	fmt.Fprint(f, d.String())
+}
+
+// NewOpaqueSecret builds a generic secret holding arbitrary key/value pairs.
+func NewOpaqueSecret(name string, data map[string][]byte) Secret {
+	return Secret{
+		Name: name,
+		Type: api.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// NewDockerConfigSecret builds a registry credential usable in imagePullSecrets.
+func NewDockerConfigSecret(name, server, username, password string) (Secret, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	config := map[string]interface{}{
+		"auths": map[string]interface{}{
+			server: map[string]string{
+				"username": username,
+				"password": password,
+				"auth":     auth,
+			},
+		},
+	}
+	content, err := json.Marshal(config)
+	if err != nil {
+		return Secret{}, err
+	}
+
+	return Secret{
+		Name: name,
+		Type: api.SecretTypeDockerConfigJson,
+		Data: SecretData{
+			api.DockerConfigJsonKey: content,
+		},
+	}, nil
+}
+
+// NewTLSSecret builds a TLS secret from PEM encoded certificate and key.
+func NewTLSSecret(name string, cert, key []byte) Secret {
+	return Secret{
+		Name: name,
+		Type: api.SecretTypeTLS,
+		Data: SecretData{
+			api.TLSCertKey:       cert,
+			api.TLSPrivateKeyKey: key,
+		},
+	}
+}
+
+// NewBasicAuthSecret builds a secret holding basic authentication credentials.
+func NewBasicAuthSecret(name, username, password string) Secret {
+	return Secret{
+		Name: name,
+		Type: api.SecretTypeBasicAuth,
+		Data: SecretData{
+			api.BasicAuthUsernameKey: []byte(username),
+			api.BasicAuthPasswordKey: []byte(password),
+		},
+	}
+}
+
+func (s Secret) API() NamespacedResourceAPI {
+	return &SecretAPI{}
+}
+
+func (s Secret) Dump(from interface{}) (interface{}, error) {
+	res := &api.Secret{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (s Secret) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type SecretAPI struct {
+	base.KubeAPI
+}
+
+func (s *SecretAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := s.Client.CoreV1().
+		Secrets(namespace).
+		Get(s.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (s *SecretAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.Secret)
+	_, err := s.Client.CoreV1().
+		Secrets(namespace).
+		Create(s.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (s *SecretAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.Secret)
+	_, err := s.Client.CoreV1().
+		Secrets(namespace).
+		Update(s.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *SecretAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := s.Client.CoreV1().
+		Secrets(namespace).
+		List(s.Context, s.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (s *SecretAPI) Delete(name, namespace string) error {
+	return s.Client.CoreV1().
+		Secrets(namespace).
+		Delete(s.Context, name, metav1.DeleteOptions{})
+}
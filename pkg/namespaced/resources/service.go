@@ -5,7 +5,9 @@ import (
 
 	sm "github.com/ilexPar/struct-marshal/pkg"
 	api "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type Service struct {
@@ -15,6 +17,26 @@ type Service struct {
 	Labels   map[string]string `sm:"metadata.labels"`
 }
 
+// ServiceEndpoints lists every address and port combination backing a
+// service, as reported by its EndpointSlices
+type ServiceEndpoints struct {
+	Ready    []Endpoint
+	NotReady []Endpoint
+}
+
+func (se ServiceEndpoints) HasReadyEndpoints() bool {
+	return len(se.Ready) > 0
+}
+
+type Endpoint struct {
+	Address  string
+	Pod      string
+	Node     string
+	Port     int
+	PortName string
+	Protocol api.Protocol
+}
+
 func (s Service) API() NamespacedResourceAPI {
 	return &ServiceAPI{}
 }
@@ -72,3 +94,59 @@ func (s *ServiceAPI) Delete(name, namespace string) error {
 		Services(namespace).
 		Delete(s.Context, name, metav1.DeleteOptions{})
 }
+
+func (s *ServiceAPI) Endpoints(name, namespace string) (ServiceEndpoints, error) {
+	res := ServiceEndpoints{
+		Ready:    []Endpoint{},
+		NotReady: []Endpoint{},
+	}
+	_, err := s.Client.CoreV1().
+		Services(namespace).
+		Get(s.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return res, err
+	}
+	selector := labels.Set{discovery.LabelServiceName: name}
+	slices, err := s.Client.DiscoveryV1().
+		EndpointSlices(namespace).
+		List(s.Context, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return res, err
+	}
+	for _, slice := range slices.Items {
+		ports := slice.Ports
+		if len(ports) == 0 {
+			// headless services without ports still report addresses
+			ports = []discovery.EndpointPort{{}}
+		}
+		for _, endpoint := range slice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				for _, port := range ports {
+					e := Endpoint{Address: address}
+					if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+						e.Pod = endpoint.TargetRef.Name
+					}
+					if endpoint.NodeName != nil {
+						e.Node = *endpoint.NodeName
+					}
+					if port.Port != nil {
+						e.Port = int(*port.Port)
+					}
+					if port.Name != nil {
+						e.PortName = *port.Name
+					}
+					if port.Protocol != nil {
+						e.Protocol = *port.Protocol
+					}
+					// an unknown ready condition should be interpreted as ready
+					if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+						res.Ready = append(res.Ready, e)
+					} else {
+						res.NotReady = append(res.NotReady, e)
+					}
+				}
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ServiceAccount struct {
+	Name             string            `sm:"metadata.name"`
+	Labels           map[string]string `sm:"metadata.labels"`
+	Annotations      map[string]string `sm:"metadata.annotations"`
+	ImagePullSecrets []LocalReference  `sm:"imagePullSecrets"`
+	AutomountToken   *bool             `sm:"automountServiceAccountToken"`
+}
+
+type LocalReference struct {
+	Name string `sm:"name"`
+}
+
+func (sa ServiceAccount) API() NamespacedResourceAPI {
+	return &ServiceAccountAPI{}
+}
+
+func (sa ServiceAccount) Dump(from interface{}) (interface{}, error) {
+	res := &api.ServiceAccount{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (sa ServiceAccount) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type ServiceAccountAPI struct {
+	base.KubeAPI
+}
+
+func (sa *ServiceAccountAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := sa.Client.CoreV1().
+		ServiceAccounts(namespace).
+		Get(sa.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (sa *ServiceAccountAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*api.ServiceAccount)
+	_, err := sa.Client.CoreV1().
+		ServiceAccounts(namespace).
+		Create(sa.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (sa *ServiceAccountAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*api.ServiceAccount)
+	_, err := sa.Client.CoreV1().
+		ServiceAccounts(namespace).
+		Update(sa.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (sa *ServiceAccountAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := sa.Client.CoreV1().
+		ServiceAccounts(namespace).
+		List(sa.Context, sa.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (sa *ServiceAccountAPI) Delete(name, namespace string) error {
+	return sa.Client.CoreV1().
+		ServiceAccounts(namespace).
+		Delete(sa.Context, name, metav1.DeleteOptions{})
+}
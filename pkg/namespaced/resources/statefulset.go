@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/base"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type StatefulSet struct {
+	Name                 string                       `sm:"metadata.name"`
+	ServiceName          string                       `sm:"spec.serviceName"`
+	Replicas             int                          `sm:"spec.replicas"`
+	PodManagementPolicy  apps.PodManagementPolicyType `sm:"spec.podManagementPolicy"`
+	ServiceAccount       string                       `sm:"spec.template.spec.serviceAccountName"`
+	Containers           []Container                  `sm:"spec.template.spec.containers"`
+	InitContainers       []Container                  `sm:"spec.template.spec.initContainers"`
+	Scheduling           PodScheduling                `sm:"spec.template.spec"`
+	Volumes              []Volume                     `sm:"spec.template.spec.volumes"`
+	VolumeClaimTemplates []VolumeClaimTemplate        `sm:"spec.volumeClaimTemplates"`
+	Labels               map[string]string            `sm:"metadata.labels"`
+	TemplateLabels       map[string]string            `sm:"spec.template.metadata.labels"`
+	ServiceSelector      map[string]string            `sm:"spec.selector.matchLabels"`
+	NodeSelector         map[string]string            `sm:"spec.template.spec.nodeSelector"`
+}
+
+type VolumeClaimTemplate struct {
+	Name         string                          `sm:"metadata.name"`
+	StorageClass string                          `sm:"spec.storageClassName"`
+	AccessModes  []v1.PersistentVolumeAccessMode `sm:"spec.accessModes"`
+	Size         string                          `sm:"spec.resources.requests.storage"`
+}
+
+func (s StatefulSet) API() NamespacedResourceAPI {
+	return &StatefulSetAPI{}
+}
+
+func (s StatefulSet) Dump(from interface{}) (interface{}, error) {
+	res := &apps.StatefulSet{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (s StatefulSet) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type StatefulSetAPI struct {
+	base.KubeAPI
+}
+
+func (s *StatefulSetAPI) Get(name, namespace string) (interface{}, error) {
+	res, err := s.Client.AppsV1().
+		StatefulSets(namespace).
+		Get(s.Context, name, metav1.GetOptions{})
+	return res, err
+}
+
+func (s *StatefulSetAPI) Create(namespace string, obj interface{}) error {
+	res := obj.(*apps.StatefulSet)
+	_, err := s.Client.AppsV1().
+		StatefulSets(namespace).
+		Create(s.Context, res, metav1.CreateOptions{})
+	return err
+}
+
+func (s *StatefulSetAPI) Update(namespace string, obj interface{}) error {
+	res := obj.(*apps.StatefulSet)
+	_, err := s.Client.AppsV1().
+		StatefulSets(namespace).
+		Update(s.Context, res, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *StatefulSetAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := s.Client.AppsV1().
+		StatefulSets(namespace).
+		List(s.Context, s.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (s *StatefulSetAPI) Delete(name, namespace string) error {
+	return s.Client.AppsV1().
+		StatefulSets(namespace).
+		Delete(s.Context, name, metav1.DeleteOptions{})
+}
@@ -2,9 +2,14 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/ilexPar/simple-kube/pkg/base"
 
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -20,24 +25,228 @@ type NamespacedResourceAPI interface {
 }
 
 type Container struct {
-	Name      string              `sm:"name"`
-	Image     string              `sm:"image"`
-	Ports     []ContainerPort     `sm:"ports"`
-	Command   []string            `sm:"command"`
-	Resources *ContainerResources `sm:"resources.limits"`
-	Env       []EnvVar            `sm:"env"`
+	Name         string             `sm:"name"`
+	Image        string             `sm:"image"`
+	Ports        []ContainerPort    `sm:"ports"`
+	Command      []string           `sm:"command"`
+	Resources    ContainerResources `sm:"resources"`
+	Env          []EnvVar           `sm:"env"`
+	EnvFrom      []EnvFromSource    `sm:"envFrom"`
+	VolumeMounts []VolumeMount      `sm:"volumeMounts"`
+	Liveness     *Probe             `sm:"livenessProbe"`
+	Readiness    *Probe             `sm:"readinessProbe"`
+	Startup      *Probe             `sm:"startupProbe"`
+	// Only allowed on init containers, Always turns them into sidecars that
+	// start before the main containers and keep running alongside them
+	RestartPolicy v1.ContainerRestartPolicy `sm:"restartPolicy"`
 }
 
 type ContainerPort struct {
 	Port int `sm:"containerPort"`
 }
 
+// ContainerResources are keyed by resource name, like "cpu", "memory",
+// "ephemeral-storage" or extended resources such as "nvidia.com/gpu"
 type ContainerResources struct {
-	Cpu    string `json:"cpu"`
-	Memory string `json:"memory"`
+	Requests map[string]string `sm:"requests"`
+	Limits   map[string]string `sm:"limits"`
 }
 
-type EnvVar struct {
+// Probe handlers are mutually exclusive, only one of HTTPGet, TCPSocket, Exec
+// or GRPC should be set
+type Probe struct {
+	HTTPGet             *HTTPGetAction   `sm:"httpGet"`
+	TCPSocket           *TCPSocketAction `sm:"tcpSocket"`
+	Exec                []string         `sm:"exec.command"`
+	GRPC                *GRPCAction      `sm:"grpc"`
+	InitialDelaySeconds int              `sm:"initialDelaySeconds"`
+	PeriodSeconds       int              `sm:"periodSeconds"`
+	TimeoutSeconds      int              `sm:"timeoutSeconds"`
+	SuccessThreshold    int              `sm:"successThreshold"`
+	FailureThreshold    int              `sm:"failureThreshold"`
+}
+
+type HTTPGetAction struct {
+	Path    string             `sm:"path"`
+	Port    intstr.IntOrString `sm:"port"`
+	Host    string             `sm:"host"`
+	Scheme  v1.URIScheme       `sm:"scheme"`
+	Headers []HTTPHeader       `sm:"httpHeaders"`
+}
+
+type HTTPHeader struct {
 	Name  string `sm:"name"`
 	Value string `sm:"value"`
 }
+
+type TCPSocketAction struct {
+	Port intstr.IntOrString `sm:"port"`
+	Host string             `sm:"host"`
+}
+
+type GRPCAction struct {
+	Port    int    `sm:"port"`
+	Service string `sm:"service"`
+}
+
+// Value sources are mutually exclusive, set either Value or one of the
+// references
+type EnvVar struct {
+	Name          string                 `sm:"name"`
+	Value         string                 `sm:"value"`
+	ConfigMap     *KeySelector           `sm:"valueFrom.configMapKeyRef"`
+	Secret        *KeySelector           `sm:"valueFrom.secretKeyRef"`
+	Field         string                 `sm:"valueFrom.fieldRef.fieldPath"`
+	ResourceField *ResourceFieldSelector `sm:"valueFrom.resourceFieldRef"`
+}
+
+// KeySelector references a single key of a ConfigMap or Secret
+type KeySelector struct {
+	Name     string `sm:"name"`
+	Key      string `sm:"key"`
+	Optional bool   `sm:"optional"`
+}
+
+// ResourceFieldSelector exposes a container request or limit, like
+// "limits.memory", scaled down by Divisor
+type ResourceFieldSelector struct {
+	Container string `sm:"containerName"`
+	Resource  string `sm:"resource"`
+	Divisor   string `sm:"divisor"`
+}
+
+// EnvFromSource imports every key of a ConfigMap or Secret, only one of them
+// should be set
+type EnvFromSource struct {
+	Prefix    string `sm:"prefix"`
+	ConfigMap string `sm:"configMapRef.name"`
+	Secret    string `sm:"secretRef.name"`
+}
+
+type VolumeMount struct {
+	Name     string `sm:"name"`
+	Path     string `sm:"mountPath"`
+	SubPath  string `sm:"subPath"`
+	ReadOnly bool   `sm:"readOnly"`
+}
+
+// Volume sources are mutually exclusive, only one of them should be set
+type Volume struct {
+	Name      string          `sm:"name"`
+	PVC       string          `sm:"persistentVolumeClaim.claimName"`
+	ConfigMap string          `sm:"configMap.name"`
+	Secret    string          `sm:"secret.secretName"`
+	EmptyDir  *EmptyDirVolume `sm:"emptyDir"`
+}
+
+type EmptyDirVolume struct {
+	Medium    v1.StorageMedium `sm:"medium"`
+	SizeLimit string           `sm:"sizeLimit"`
+}
+
+type Toleration struct {
+	Key      string                `sm:"key"`
+	Operator v1.TolerationOperator `sm:"operator"`
+	Value    string                `sm:"value"`
+	Effect   v1.TaintEffect        `sm:"effect"`
+	Seconds  *int64                `sm:"tolerationSeconds"`
+}
+
+// PodScheduling groups the pod spec fields controlling where pods are placed,
+// every resource with a pod template maps it to its pod spec
+type PodScheduling struct {
+	Tolerations     []Toleration               `sm:"tolerations"`
+	NodeAffinity    *NodeAffinity              `sm:"affinity.nodeAffinity"`
+	PodAffinity     *PodAffinity               `sm:"affinity.podAffinity"`
+	PodAntiAffinity *PodAffinity               `sm:"affinity.podAntiAffinity"`
+	TopologySpread  []TopologySpreadConstraint `sm:"topologySpreadConstraints"`
+}
+
+type NodeAffinity struct {
+	// Terms are ORed, a node must match at least one of them
+	Required  []NodeSelectorTerm          `sm:"requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms"`
+	Preferred []PreferredNodeSelectorTerm `sm:"preferredDuringSchedulingIgnoredDuringExecution"`
+}
+
+type NodeSelectorTerm struct {
+	MatchExpressions []NodeSelectorRequirement `sm:"matchExpressions"`
+	MatchFields      []NodeSelectorRequirement `sm:"matchFields"`
+}
+
+type NodeSelectorRequirement struct {
+	Key      string                  `sm:"key"`
+	Operator v1.NodeSelectorOperator `sm:"operator"`
+	Values   []string                `sm:"values"`
+}
+
+type PreferredNodeSelectorTerm struct {
+	Weight int              `sm:"weight"`
+	Term   NodeSelectorTerm `sm:"preference"`
+}
+
+// PodAffinity is used both for affinity and anti-affinity, which only differ
+// on whether pods matching the terms attract or repel the new pod
+type PodAffinity struct {
+	Required  []PodAffinityTerm         `sm:"requiredDuringSchedulingIgnoredDuringExecution"`
+	Preferred []WeightedPodAffinityTerm `sm:"preferredDuringSchedulingIgnoredDuringExecution"`
+}
+
+type PodAffinityTerm struct {
+	Selector          *LabelSelector `sm:"labelSelector"`
+	Namespaces        []string       `sm:"namespaces"`
+	NamespaceSelector *LabelSelector `sm:"namespaceSelector"`
+	TopologyKey       string         `sm:"topologyKey"`
+}
+
+type WeightedPodAffinityTerm struct {
+	Weight int             `sm:"weight"`
+	Term   PodAffinityTerm `sm:"podAffinityTerm"`
+}
+
+type TopologySpreadConstraint struct {
+	MaxSkew           int                              `sm:"maxSkew"`
+	TopologyKey       string                           `sm:"topologyKey"`
+	WhenUnsatisfiable v1.UnsatisfiableConstraintAction `sm:"whenUnsatisfiable"`
+	Selector          *LabelSelector                   `sm:"labelSelector"`
+	MinDomains        int                              `sm:"minDomains"`
+	MatchLabelKeys    []string                         `sm:"matchLabelKeys"`
+}
+
+type LabelSelector = base.LabelSelector
+
+type LabelSelectorRequirement = base.LabelSelectorRequirement
+
+// Timestamp is used for every time field, kube objects mix second precision
+// (metav1.Time) and microsecond precision (metav1.MicroTime) timestamps, it
+// reads both and writes microseconds
+type Timestamp struct {
+	time.Time
+}
+
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{t}
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.UTC().Format(metav1.RFC3339Micro))
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var value *string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == nil || *value == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
@@ -0,0 +1,30 @@
+package namespaced
+
+import (
+	"github.com/ilexPar/simple-kube/pkg/errors"
+	"github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+)
+
+type ServiceActions struct {
+	*Action[resources.Service]
+	services *resources.ServiceAPI
+}
+
+func (sa *ServiceActions) Endpoints(name string) ServiceEndpointsInterface {
+	return &ServiceEndpointsLookup{
+		services:  sa.services,
+		namespace: sa.namespace,
+		Id:        name,
+	}
+}
+
+type ServiceEndpointsLookup struct {
+	services  *resources.ServiceAPI
+	namespace string
+	Id        string
+}
+
+func (l *ServiceEndpointsLookup) Run() (resources.ServiceEndpoints, error) {
+	res, err := l.services.Endpoints(l.Id, l.namespace)
+	return res, errors.Format(err)
+}
@@ -11,23 +11,41 @@ type NamespacedResourceMethods interface {
 	API() resources.NamespacedResourceAPI
 }
 
-type NamespacedResourcesConstrain interface {
-	resources.Deployment | resources.Service | resources.Job | resources.CronJob | resources.ConfigMap | resources.Ingress | resources.HPA
-}
-
+// NamespacedResources is satisfied by any type implementing the resource
+// methods, see For
 type NamespacedResources interface {
-	NamespacedResourcesConstrain
 	NamespacedResourceMethods
 }
 
 type QueryNamespace interface {
-	Deployment() NamespacedAction[resources.Deployment]
-	Service() NamespacedAction[resources.Service]
+	// Namespace, ResourceAPI and DynamicAPI are what For and Custom build
+	// actions from, wrappers around a query usually delegate them
+	Namespace() string
+	ResourceAPI(NamespacedResourceMethods) resources.NamespacedResourceAPI
+	DynamicAPI(base.DynamicResource) resources.NamespacedResourceAPI
+
+	Deployment() DeploymentAction
+	Service() ServiceAction
 	Job() NamespacedAction[resources.Job]
 	CronJob() NamespacedAction[resources.CronJob]
 	ConfigMap() NamespacedAction[resources.ConfigMap]
 	Ingress() NamespacedAction[resources.Ingress]
 	HPA() NamespacedAction[resources.HPA]
+	StatefulSet() NamespacedAction[resources.StatefulSet]
+	DaemonSet() NamespacedAction[resources.DaemonSet]
+	Secret() NamespacedAction[resources.Secret]
+	Pod() NamespacedAction[resources.Pod]
+	PersistentVolumeClaim() NamespacedAction[resources.PersistentVolumeClaim]
+	ServiceAccount() NamespacedAction[resources.ServiceAccount]
+	Role() NamespacedAction[resources.Role]
+	RoleBinding() NamespacedAction[resources.RoleBinding]
+	NetworkPolicy() NamespacedAction[resources.NetworkPolicy]
+	PodDisruptionBudget() NamespacedAction[resources.PodDisruptionBudget]
+	ResourceQuota() ResourceQuotaAction
+	LimitRange() NamespacedAction[resources.LimitRange]
+	Event() EventAction
+	ReplicaSet() NamespacedAction[resources.ReplicaSet]
+	Lease() NamespacedAction[resources.Lease]
 }
 
 type NamespacedAction[T NamespacedResources] interface {
@@ -56,3 +74,44 @@ type NamespacedListInterface[T NamespacedResources] interface {
 type NamespacedDeleteInterface[T NamespacedResources] interface {
 	Run() error
 }
+
+type ResourceQuotaAction interface {
+	NamespacedAction[resources.ResourceQuota]
+	Utilization() ResourceQuotaUtilizationInterface
+}
+
+type ResourceQuotaUtilizationInterface interface {
+	Run() ([]resources.QuotaUsage, error)
+}
+
+type EventAction interface {
+	NamespacedAction[resources.Event]
+	Involving(kind, name string) EventLookupInterface
+}
+
+type EventLookupInterface interface {
+	Run() ([]resources.Event, error)
+}
+
+type DeploymentAction interface {
+	NamespacedAction[resources.Deployment]
+	History(name string) DeploymentHistoryInterface
+	Undo(name string, revision int64) DeploymentUndoInterface
+}
+
+type DeploymentHistoryInterface interface {
+	Run() ([]resources.DeploymentRevision, error)
+}
+
+type DeploymentUndoInterface interface {
+	Run() error
+}
+
+type ServiceAction interface {
+	NamespacedAction[resources.Service]
+	Endpoints(name string) ServiceEndpointsInterface
+}
+
+type ServiceEndpointsInterface interface {
+	Run() (resources.ServiceEndpoints, error)
+}
@@ -0,0 +1,461 @@
+package cluster_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	certificates "k8s.io/api/certificates/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCertificateSigningRequestCreate(t *testing.T) {
+	new := skres.CertificateSigningRequest{
+		Name:       "deploy-bot",
+		Request:    []byte("request"),
+		SignerName: certificates.KubeAPIServerClientSignerName,
+		Usages: []certificates.KeyUsage{
+			certificates.UsageDigitalSignature,
+			certificates.UsageClientAuth,
+		},
+		ExpirationSeconds: 3600,
+		Status: skres.CertificateSigningRequestStatus{
+			Certificate: []byte("certificate"),
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.CertificateSigningRequest](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				CertificateSigningRequest().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.CertificateSigningRequest](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				CertificateSigningRequest().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*certificates.CertificateSigningRequest)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, []byte("request"), obj.Spec.Request)
+					assert.Equal(t, certificates.KubeAPIServerClientSignerName, obj.Spec.SignerName)
+					assert.Equal(t, new.Usages, obj.Spec.Usages)
+					assert.Equal(t, int32(3600), *obj.Spec.ExpirationSeconds)
+					assert.Empty(t, obj.Status.Certificate)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestCertificateSigningRequestUpdate(t *testing.T) {
+	old := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot",
+		},
+	}
+	new := skres.CertificateSigningRequest{
+		Name:   "deploy-bot",
+		Labels: map[string]string{"tool": "deploy-bot"},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.CertificateSigningRequest](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				CertificateSigningRequest().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.CertificateSigningRequest](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				CertificateSigningRequest().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*certificates.CertificateSigningRequest)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, new.Labels, obj.Labels)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestCertificateSigningRequestGet(t *testing.T) {
+	kubeCertificateSigningRequest := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot",
+		},
+		Spec: certificates.CertificateSigningRequestSpec{
+			Request:    []byte("request"),
+			SignerName: certificates.KubeAPIServerClientSignerName,
+			Usages:     []certificates.KeyUsage{certificates.UsageClientAuth},
+		},
+		Status: certificates.CertificateSigningRequestStatus{
+			Conditions: []certificates.CertificateSigningRequestCondition{
+				{
+					Type:   certificates.CertificateApproved,
+					Status: v1.ConditionTrue,
+					Reason: "ToolingApproved",
+				},
+			},
+			Certificate: []byte("certificate"),
+		},
+	}
+	expected := skres.CertificateSigningRequest{
+		Name:       "deploy-bot",
+		Request:    []byte("request"),
+		SignerName: certificates.KubeAPIServerClientSignerName,
+		Usages:     []certificates.KeyUsage{certificates.UsageClientAuth},
+		Status: skres.CertificateSigningRequestStatus{
+			Conditions: []skres.CertificateSigningRequestCondition{
+				{
+					Type:   certificates.CertificateApproved,
+					Status: v1.ConditionTrue,
+					Reason: "ToolingApproved",
+				},
+			},
+			Certificate: []byte("certificate"),
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeCertificateSigningRequest))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Get("deploy-bot")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Get("deploy-bot").
+			DataHandler(func(res interface{}) error {
+				request := res.(*certificates.CertificateSigningRequest)
+				request.Status.Certificate = nil
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result.Status.Certificate)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Get("deploy-bot").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestCertificateSigningRequestList(t *testing.T) {
+	request1 := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	request2 := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot2",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(request1, request2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestCertificateSigningRequestDelete(t *testing.T) {
+	request := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(request)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			CertificateSigningRequest().
+			Delete("deploy-bot")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "certificatesigningrequests"))
+	})
+}
+
+func TestGenerateCertificateRequest(t *testing.T) {
+	t.Run("should return a key and a request signed with it", func(t *testing.T) {
+		key, request, err := skres.GenerateCertificateRequest(skres.CertificateRequestOptions{
+			CommonName:    "deploy-bot",
+			Organizations: []string{"platform"},
+			DNSNames:      []string{"deploy-bot.internal"},
+		})
+		assert.Nil(t, err)
+
+		keyBlock, _ := pem.Decode(key)
+		assert.Equal(t, "EC PRIVATE KEY", keyBlock.Type)
+		privateKey, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+		assert.Nil(t, err)
+
+		requestBlock, _ := pem.Decode(request)
+		assert.Equal(t, "CERTIFICATE REQUEST", requestBlock.Type)
+		parsed, err := x509.ParseCertificateRequest(requestBlock.Bytes)
+		assert.Nil(t, err)
+		assert.Nil(t, parsed.CheckSignature())
+		assert.Equal(t, "deploy-bot", parsed.Subject.CommonName)
+		assert.Equal(t, []string{"platform"}, parsed.Subject.Organization)
+		assert.Equal(t, []string{"deploy-bot.internal"}, parsed.DNSNames)
+		assert.True(t, privateKey.PublicKey.Equal(parsed.PublicKey))
+	})
+}
+
+func TestCertificateSigningRequestApproval(t *testing.T) {
+	pending := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot",
+		},
+		Spec: certificates.CertificateSigningRequestSpec{
+			SignerName: certificates.KubeAPIServerClientSignerName,
+		},
+	}
+
+	t.Run("should approve the request", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(pending.DeepCopy())
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.ClusterQuery().
+			CertificateSigningRequest().
+			Approve("deploy-bot", "ToolingApproved", "approved by bootstrap").
+			Run()
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[1].Matches("update", "certificatesigningrequests"))
+		assert.Equal(t, "approval", k8s.Actions()[1].GetSubresource())
+
+		result, err := client.ClusterQuery().
+			CertificateSigningRequest().
+			Get("deploy-bot").
+			Run()
+		assert.Nil(t, err)
+		assert.True(t, result.Approved())
+		assert.False(t, result.Denied())
+		assert.Equal(t, "ToolingApproved", result.Status.Conditions[0].Reason)
+	})
+	t.Run("should deny the request", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(pending.DeepCopy())
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.ClusterQuery().
+			CertificateSigningRequest().
+			Deny("deploy-bot", "UnknownTool", "not an internal tool").
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.ClusterQuery().
+			CertificateSigningRequest().
+			Get("deploy-bot").
+			Run()
+		assert.Nil(t, err)
+		assert.True(t, result.Denied())
+		assert.False(t, result.Approved())
+	})
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+
+		err := client.ClusterQuery().
+			CertificateSigningRequest().
+			Approve("not-found", "", "").
+			Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+}
+
+func TestCertificateSigningRequestWaitForCertificate(t *testing.T) {
+	pending := &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-bot",
+		},
+	}
+
+	t.Run("should return the issued certificate", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(pending.DeepCopy())
+		client := sk.NewClient(context.Background(), k8s)
+		go func() {
+			// wait for the watch to be established before signing
+			for len(k8s.Actions()) < 2 {
+				time.Sleep(time.Millisecond)
+			}
+			issued := pending.DeepCopy()
+			issued.Status.Certificate = []byte("certificate")
+			_, err := k8s.CertificatesV1().
+				CertificateSigningRequests().
+				UpdateStatus(context.Background(), issued, metav1.UpdateOptions{})
+			assert.Nil(t, err)
+		}()
+
+		cert, err := client.ClusterQuery().
+			CertificateSigningRequest().
+			WaitForCertificate("deploy-bot", 3*time.Second).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("certificate"), cert)
+	})
+	t.Run("should return already issued certificates", func(t *testing.T) {
+		issued := pending.DeepCopy()
+		issued.Status.Certificate = []byte("certificate")
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(issued))
+
+		cert, err := client.ClusterQuery().
+			CertificateSigningRequest().
+			WaitForCertificate("deploy-bot", time.Second).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("certificate"), cert)
+	})
+	t.Run("should fail when the request is denied", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(pending.DeepCopy()))
+		query := client.ClusterQuery().CertificateSigningRequest()
+		assert.Nil(t, query.Deny("deploy-bot", "UnknownTool", "not an internal tool").Run())
+
+		_, err := query.WaitForCertificate("deploy-bot", time.Second).Run()
+
+		assert.Equal(t, "certificate signing request deploy-bot was denied: not an internal tool", err.Error())
+	})
+	t.Run("should time out", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(pending.DeepCopy()))
+
+		_, err := client.ClusterQuery().
+			CertificateSigningRequest().
+			WaitForCertificate("deploy-bot", 10*time.Millisecond).
+			Run()
+
+		assert.Equal(t, "timed out waiting for certificate signing request deploy-bot", err.Error())
+	})
+}
@@ -0,0 +1,366 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/base"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClusterRoleCreate(t *testing.T) {
+	new := skres.ClusterRole{
+		Name: "monitoring",
+		Aggregation: []base.LabelSelector{
+			{
+				MatchLabels: map[string]string{
+					"rbac.example.com/aggregate-to-monitoring": "true",
+				},
+			},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRole](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRole().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRole](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRole().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*rbac.ClusterRole)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(
+						t,
+						new.Aggregation[0].MatchLabels,
+						obj.AggregationRule.ClusterRoleSelectors[0].MatchLabels,
+					)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ClusterRole().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestClusterRoleUpdate(t *testing.T) {
+	old := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-reader",
+		},
+		Rules: []rbac.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes"},
+				Verbs:     []string{"get"},
+			},
+		},
+	}
+	new := skres.ClusterRole{
+		Name: "node-reader",
+		Rules: []base.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRole](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRole().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRole](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRole().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*rbac.ClusterRole)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ClusterRole().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestClusterRoleGet(t *testing.T) {
+	kubeRole := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "monitoring",
+		},
+		Rules: []rbac.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get"},
+			},
+		},
+		AggregationRule: &rbac.AggregationRule{
+			ClusterRoleSelectors: []metav1.LabelSelector{
+				{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "aggregate-to",
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"monitoring"},
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := skres.ClusterRole{
+		Name: "monitoring",
+		Rules: []base.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get"},
+			},
+		},
+		Aggregation: []base.LabelSelector{
+			{
+				MatchExpressions: []base.LabelSelectorRequirement{
+					{
+						Key:      "aggregate-to",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"monitoring"},
+					},
+				},
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeRole))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRole().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRole().
+			Get("monitoring")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRole().
+			Get("monitoring").
+			DataHandler(func(res interface{}) error {
+				role := res.(*rbac.ClusterRole)
+				role.Rules[0].Verbs = []string{"*"}
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"*"}, result.Rules[0].Verbs)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRole().
+			Get("monitoring").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestClusterRoleList(t *testing.T) {
+	role1 := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "monitoring",
+			Labels: map[string]string{
+				"app":  "platform",
+				"some": "label",
+			},
+		},
+	}
+	role2 := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-reader",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(role1, role2))
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRole().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRole().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "platform",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestClusterRoleDelete(t *testing.T) {
+	role := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "monitoring",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(role)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ClusterRole().
+			Delete("monitoring")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "clusterroles"))
+	})
+}
+
+func TestClusterRoleNonResourceURLs(t *testing.T) {
+	new := skres.ClusterRole{
+		Name: "metrics-reader",
+		Rules: []base.PolicyRule{
+			{
+				NonResourceURLs: []string{"/healthz", "/metrics"},
+				Verbs:           []string{"get"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes/metrics"},
+				Verbs:     []string{"get"},
+			},
+		},
+	}
+
+	t.Run("should keep non resource rules through get and update", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		roles := client.ClusterQuery().ClusterRole()
+
+		err := roles.Create(new).
+			DataHandler(func(res interface{}) error {
+				rules := res.(*rbac.ClusterRole).Rules
+				assert.Equal(t, []string{"/healthz", "/metrics"}, rules[0].NonResourceURLs)
+				assert.Nil(t, rules[1].NonResourceURLs)
+				return nil
+			}).
+			Run()
+		assert.Nil(t, err)
+		result, err := roles.Get("metrics-reader").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+
+		result.Labels = map[string]string{"app": "platform"}
+		err = roles.Update(result).Run()
+		assert.Nil(t, err)
+		updated, err := roles.Get("metrics-reader").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Rules, updated.Rules)
+	})
+}
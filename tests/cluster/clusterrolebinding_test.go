@@ -0,0 +1,313 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/base"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClusterRoleBindingCreate(t *testing.T) {
+	new := skres.ClusterRoleBinding{
+		Name: "operator",
+		RoleRef: base.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []base.Subject{
+			{
+				Kind:      rbac.ServiceAccountKind,
+				Name:      "operator",
+				Namespace: "platform",
+			},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRoleBinding](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRoleBinding().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRoleBinding](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRoleBinding().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*rbac.ClusterRoleBinding)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "cluster-admin", obj.RoleRef.Name)
+					assert.Equal(t, "platform", obj.Subjects[0].Namespace)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestClusterRoleBindingUpdate(t *testing.T) {
+	old := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "operator",
+		},
+		RoleRef: rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+	}
+	new := skres.ClusterRoleBinding{
+		Name: "operator",
+		RoleRef: base.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []base.Subject{
+			{
+				Kind:     rbac.UserKind,
+				APIGroup: rbac.GroupName,
+				Name:     "jane",
+			},
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRoleBinding](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRoleBinding().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.ClusterRoleBinding](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ClusterRoleBinding().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*rbac.ClusterRoleBinding)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestClusterRoleBindingGet(t *testing.T) {
+	kubeBinding := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "operator",
+		},
+		RoleRef: rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []rbac.Subject{
+			{
+				Kind:      rbac.ServiceAccountKind,
+				Name:      "operator",
+				Namespace: "platform",
+			},
+		},
+	}
+	expected := skres.ClusterRoleBinding{
+		Name: "operator",
+		RoleRef: base.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []base.Subject{
+			{
+				Kind:      rbac.ServiceAccountKind,
+				Name:      "operator",
+				Namespace: "platform",
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeBinding))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Get("operator")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Get("operator").
+			DataHandler(func(res interface{}) error {
+				binding := res.(*rbac.ClusterRoleBinding)
+				binding.Subjects[0].Name = "overrided"
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "overrided", result.Subjects[0].Name)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Get("operator").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestClusterRoleBindingList(t *testing.T) {
+	binding1 := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "operator",
+			Labels: map[string]string{
+				"app":  "platform",
+				"some": "label",
+			},
+		},
+	}
+	binding2 := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "readers",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(binding1, binding2))
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "platform",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestClusterRoleBindingDelete(t *testing.T) {
+	binding := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "operator",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(binding)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ClusterRoleBinding().
+			Delete("operator")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "clusterrolebindings"))
+	})
+}
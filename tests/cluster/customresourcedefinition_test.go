@@ -0,0 +1,202 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skcl "github.com/ilexPar/simple-kube/pkg/cluster"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type ClusterIssuer struct {
+	skres.CustomResource
+	Name   string `sm:"metadata.name"`
+	Server string `sm:"spec.acme.server"`
+	Email  string `sm:"spec.acme.email"`
+}
+
+var issuerDefinition = skres.CustomResourceDefinition{
+	Name:     "clusterissuers.cert-manager.io",
+	Group:    "cert-manager.io",
+	Scope:    "Cluster",
+	Kind:     "ClusterIssuer",
+	ListKind: "ClusterIssuerList",
+	Plural:   "clusterissuers",
+	Singular: "clusterissuer",
+	Versions: []skres.CustomResourceDefinitionVersion{
+		{
+			Name:    "v1",
+			Served:  true,
+			Storage: true,
+			Schema: map[string]interface{}{
+				"type": "object",
+			},
+			Subresources: map[string]interface{}{
+				"status": map[string]interface{}{},
+			},
+		},
+	},
+}
+
+func newDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			skres.CustomResourceDefinitionResource.Resource: "CustomResourceDefinitionList",
+			issuerDefinition.DynamicResource("v1").Resource: issuerDefinition.ListKind,
+		},
+		objects...,
+	)
+}
+
+func newClusterQuery(dyn *dynamicfake.FakeDynamicClient) skcl.QueryCluster {
+	return sk.NewClient(context.Background(), fake.NewSimpleClientset()).
+		WithDynamicClient(dyn).
+		ClusterQuery()
+}
+
+func TestCustomResourceDefinitionCreate(t *testing.T) {
+	t.Run("should create the definition", func(t *testing.T) {
+		dyn := newDynamicClient()
+
+		err := newClusterQuery(dyn).
+			CustomResourceDefinition().
+			Create(issuerDefinition).
+			Run()
+
+		assert.Nil(t, err)
+		obj, err := dyn.Resource(skres.CustomResourceDefinitionResource.Resource).
+			Get(context.Background(), issuerDefinition.Name, metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "apiextensions.k8s.io/v1", obj.GetAPIVersion())
+		assert.Equal(t, "CustomResourceDefinition", obj.GetKind())
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+		assert.Equal(t, "ClusterIssuer", kind)
+		versions, _, _ := unstructured.NestedSlice(obj.Object, "spec", "versions")
+		assert.Equal(t, 1, len(versions))
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		dyn := newDynamicClient()
+
+		err := newClusterQuery(dyn).
+			CustomResourceDefinition().
+			Create(issuerDefinition).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			}).
+			Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(dyn.Actions()))
+	})
+}
+
+func TestCustomResourceDefinitionGet(t *testing.T) {
+	dyn := newDynamicClient()
+	query := newClusterQuery(dyn).CustomResourceDefinition()
+	assert.Nil(t, query.Create(issuerDefinition).Run())
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		_, err := query.Get("not-found").Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		result, err := query.Get(issuerDefinition.Name).Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, issuerDefinition, result)
+	})
+}
+
+func TestCustomResourceDefinitionList(t *testing.T) {
+	dyn := newDynamicClient()
+	query := newClusterQuery(dyn).CustomResourceDefinition()
+	other := issuerDefinition
+	other.Name = "issuers.cert-manager.io"
+	other.Labels = map[string]string{"app": "cert-manager"}
+	assert.Nil(t, query.Create(issuerDefinition).Run())
+	assert.Nil(t, query.Create(other).Run())
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		result, err := query.List().Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		result, err := query.List().
+			FilterByLabels(map[string]string{
+				"app": "cert-manager",
+			}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestCustomResourceDefinitionDelete(t *testing.T) {
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		dyn := newDynamicClient()
+		query := newClusterQuery(dyn).CustomResourceDefinition()
+		assert.Nil(t, query.Create(issuerDefinition).Run())
+
+		err := query.Delete(issuerDefinition.Name).Run()
+
+		assert.Nil(t, err)
+		assert.True(t, dyn.Actions()[1].Matches("delete", "customresourcedefinitions"))
+	})
+}
+
+func TestClusterCustomResource(t *testing.T) {
+	issuer := ClusterIssuer{
+		Name:   "letsencrypt",
+		Server: "https://acme-v02.api.letsencrypt.org/directory",
+		Email:  "ops@example.com",
+	}
+
+	t.Run("should round trip a cluster scoped custom resource", func(t *testing.T) {
+		dyn := newDynamicClient()
+		query := skcl.Custom[ClusterIssuer](
+			newClusterQuery(dyn),
+			issuerDefinition.DynamicResource("v1"),
+		)
+
+		assert.Nil(t, query.Create(issuer).Run())
+		updated := issuer
+		updated.Email = "platform@example.com"
+		assert.Nil(t, query.Update(updated).Run())
+		result, err := query.Get("letsencrypt").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, updated, result)
+		obj, _ := dyn.Resource(issuerDefinition.DynamicResource("v1").Resource).
+			Get(context.Background(), "letsencrypt", metav1.GetOptions{})
+		assert.Equal(t, "cert-manager.io/v1", obj.GetAPIVersion())
+		assert.Equal(t, "ClusterIssuer", obj.GetKind())
+		assert.Empty(t, obj.GetNamespace())
+	})
+	t.Run("should fail without a dynamic client", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+		query := skcl.Custom[ClusterIssuer](
+			client.ClusterQuery(),
+			issuerDefinition.DynamicResource("v1"),
+		)
+
+		_, err := query.List().Run()
+
+		assert.Equal(t, skerr.ERROR_NO_DYNAMIC_CLIENT, err.Error())
+	})
+}
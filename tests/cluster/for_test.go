@@ -0,0 +1,129 @@
+package cluster_test
+
+import (
+	"context"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/base"
+	skcl "github.com/ilexPar/simple-kube/pkg/cluster"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	"github.com/stretchr/testify/assert"
+	node "k8s.io/api/node/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// RuntimeClass is defined the way a downstream package would plug in a
+// resource not shipped with the library
+type RuntimeClass struct {
+	Name    string            `sm:"metadata.name"`
+	Labels  map[string]string `sm:"metadata.labels"`
+	Handler string            `sm:"handler"`
+}
+
+func (rc RuntimeClass) API() skres.ClusterResourceAPI {
+	return &RuntimeClassAPI{}
+}
+
+func (rc RuntimeClass) Dump(from interface{}) (interface{}, error) {
+	res := &node.RuntimeClass{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (rc RuntimeClass) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type RuntimeClassAPI struct {
+	base.KubeAPI
+}
+
+func (rc *RuntimeClassAPI) Get(name string) (interface{}, error) {
+	return rc.Client.NodeV1().
+		RuntimeClasses().
+		Get(rc.Context, name, metav1.GetOptions{})
+}
+
+func (rc *RuntimeClassAPI) Create(obj interface{}) error {
+	_, err := rc.Client.NodeV1().
+		RuntimeClasses().
+		Create(rc.Context, obj.(*node.RuntimeClass), metav1.CreateOptions{})
+	return err
+}
+
+func (rc *RuntimeClassAPI) Update(obj interface{}) error {
+	_, err := rc.Client.NodeV1().
+		RuntimeClasses().
+		Update(rc.Context, obj.(*node.RuntimeClass), metav1.UpdateOptions{})
+	return err
+}
+
+func (rc *RuntimeClassAPI) List() ([]interface{}, error) {
+	var res []interface{}
+	list, err := rc.Client.NodeV1().
+		RuntimeClasses().
+		List(rc.Context, rc.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (rc *RuntimeClassAPI) Delete(name string) error {
+	return rc.Client.NodeV1().
+		RuntimeClasses().
+		Delete(rc.Context, name, metav1.DeleteOptions{})
+}
+
+// wrappedQuery stands in for callers decorating the query interface
+type wrappedQuery struct {
+	skcl.QueryCluster
+}
+
+func TestForRegisteredResource(t *testing.T) {
+	class := RuntimeClass{
+		Name:    "gvisor",
+		Labels:  map[string]string{"sandbox": "true"},
+		Handler: "runsc",
+	}
+
+	t.Run("should run every action on the resource", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		classes := skcl.For[RuntimeClass](client.ClusterQuery())
+
+		assert.Nil(t, classes.Create(class).Run())
+		result, err := classes.Get("gvisor").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, class, result)
+
+		updated := class
+		updated.Handler = "runsc-debug"
+		assert.Nil(t, classes.Update(updated).Run())
+		list, err := classes.List().
+			FilterByLabels(map[string]string{"sandbox": "true"}).
+			Run()
+		assert.Nil(t, err)
+		assert.Equal(t, []RuntimeClass{updated}, list)
+
+		assert.Nil(t, classes.Delete("gvisor").Run())
+		_, err = classes.Get("gvisor").Run()
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+		assert.True(t, k8s.Actions()[0].Matches("create", "runtimeclasses"))
+	})
+	t.Run("should work with a wrapped query", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+		classes := skcl.For[RuntimeClass](wrappedQuery{client.ClusterQuery()})
+
+		assert.Nil(t, classes.Create(class).Run())
+		result, err := classes.Get("gvisor").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, class, result)
+	})
+}
@@ -0,0 +1,321 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestIngressClassCreate(t *testing.T) {
+	new := skres.IngressClass{
+		Name:       "nginx",
+		Controller: "k8s.io/ingress-nginx",
+		Parameters: &skres.IngressClassParameters{
+			APIGroup:  "k8s.example.com",
+			Kind:      "IngressParameters",
+			Name:      "external-lb",
+			Scope:     "Namespace",
+			Namespace: "ingress-nginx",
+		},
+	}
+	new.SetDefault(true)
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.IngressClass](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				IngressClass().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.IngressClass](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				IngressClass().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*networking.IngressClass)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "k8s.io/ingress-nginx", obj.Spec.Controller)
+					assert.Equal(t, "k8s.example.com", *obj.Spec.Parameters.APIGroup)
+					assert.Equal(t, "IngressParameters", obj.Spec.Parameters.Kind)
+					assert.Equal(t, "external-lb", obj.Spec.Parameters.Name)
+					assert.Equal(t, "Namespace", *obj.Spec.Parameters.Scope)
+					assert.Equal(t, "ingress-nginx", *obj.Spec.Parameters.Namespace)
+					assert.Equal(t, "true", obj.Annotations[networking.AnnotationIsDefaultIngressClass])
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			IngressClass().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestIngressClassUpdate(t *testing.T) {
+	old := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "k8s.io/ingress-nginx",
+		},
+	}
+	new := skres.IngressClass{
+		Name:       "nginx",
+		Controller: "k8s.io/ingress-nginx",
+	}
+	new.SetDefault(true)
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.IngressClass](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				IngressClass().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.IngressClass](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				IngressClass().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*networking.IngressClass)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "true", obj.Annotations[networking.AnnotationIsDefaultIngressClass])
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			IngressClass().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestIngressClassGet(t *testing.T) {
+	apiGroup := "k8s.example.com"
+	scope := "Cluster"
+	kubeIngressClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+			Annotations: map[string]string{
+				networking.AnnotationIsDefaultIngressClass: "true",
+			},
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "k8s.io/ingress-nginx",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: &apiGroup,
+				Kind:     "IngressParameters",
+				Name:     "external-lb",
+				Scope:    &scope,
+			},
+		},
+	}
+	expected := skres.IngressClass{
+		Name: "nginx",
+		Annotations: map[string]string{
+			networking.AnnotationIsDefaultIngressClass: "true",
+		},
+		Controller: "k8s.io/ingress-nginx",
+		Parameters: &skres.IngressClassParameters{
+			APIGroup: "k8s.example.com",
+			Kind:     "IngressParameters",
+			Name:     "external-lb",
+			Scope:    "Cluster",
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeIngressClass))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			IngressClass().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			IngressClass().
+			Get("nginx")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			IngressClass().
+			Get("nginx").
+			DataHandler(func(res interface{}) error {
+				class := res.(*networking.IngressClass)
+				delete(class.Annotations, networking.AnnotationIsDefaultIngressClass)
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.False(t, result.IsDefault())
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			IngressClass().
+			Get("nginx").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestIngressClassList(t *testing.T) {
+	class1 := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	class2 := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx2",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(class1, class2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			IngressClass().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			IngressClass().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestIngressClassDelete(t *testing.T) {
+	class := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(class)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			IngressClass().
+			Delete("nginx")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "ingressclasses"))
+	})
+}
+
+func TestIngressClassDefault(t *testing.T) {
+	t.Run("should set and clear the default class annotation", func(t *testing.T) {
+		class := skres.IngressClass{Name: "nginx"}
+		assert.False(t, class.IsDefault())
+
+		class.SetDefault(true)
+		assert.True(t, class.IsDefault())
+		assert.Equal(t, "true", class.Annotations["ingressclass.kubernetes.io/is-default-class"])
+
+		class.SetDefault(false)
+		assert.False(t, class.IsDefault())
+		assert.Empty(t, class.Annotations)
+	})
+}
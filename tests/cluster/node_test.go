@@ -0,0 +1,496 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	api "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeCreate(t *testing.T) {
+	new := skres.Node{
+		Name: "node-1",
+		Taints: []skres.Taint{
+			{
+				Key:    "dedicated",
+				Value:  "gpu",
+				Effect: api.TaintEffectNoSchedule,
+			},
+		},
+		Status: skres.NodeStatus{
+			Capacity: map[string]string{"cpu": "4"},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Node](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				Node().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.Node](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				Node().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Node)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "gpu", obj.Spec.Taints[0].Value)
+					assert.Empty(t, obj.Status.Capacity)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			Node().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestNodeUpdate(t *testing.T) {
+	old := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+	}
+	new := skres.Node{
+		Name: "node-1",
+		Labels: map[string]string{
+			"pool": "gpu",
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Node](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				Node().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.Node](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				Node().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Node)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			// the live node is read before being updated
+			assert.Equal(t, baseKubeActions+2, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			Node().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+	t.Run("should keep fields managed by the kubelet", func(t *testing.T) {
+		registered := old.DeepCopy()
+		registered.Annotations = map[string]string{
+			"csi.volume.kubernetes.io/nodeid": `{"ebs.csi.aws.com":"i-123"}`,
+		}
+		registered.Spec.PodCIDR = "10.244.1.0/24"
+		registered.Spec.PodCIDRs = []string{"10.244.1.0/24"}
+		registered.Spec.ProviderID = "aws:///us-east-1a/i-123"
+		k8s := fake.NewSimpleClientset(registered)
+		client := sk.NewClient(context.Background(), k8s)
+		update := new
+		update.Taints = []skres.Taint{
+			{Key: "gpu", Value: "true", Effect: api.TaintEffectNoSchedule},
+		}
+
+		err := client.ClusterQuery().Node().Update(update).Run()
+		assert.Nil(t, err)
+
+		result, err := k8s.CoreV1().
+			Nodes().
+			Get(context.Background(), "node-1", metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, registered.Spec.PodCIDR, result.Spec.PodCIDR)
+		assert.Equal(t, registered.Spec.PodCIDRs, result.Spec.PodCIDRs)
+		assert.Equal(t, registered.Spec.ProviderID, result.Spec.ProviderID)
+		assert.Equal(t, registered.Annotations, result.Annotations)
+		assert.Equal(t, new.Labels, result.Labels)
+		assert.Equal(t, "gpu", result.Spec.Taints[0].Key)
+	})
+}
+
+func TestNodeGet(t *testing.T) {
+	kubeNode := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+		Spec: api.NodeSpec{
+			Unschedulable: true,
+		},
+		Status: api.NodeStatus{
+			Capacity: api.ResourceList{
+				api.ResourceCPU:    resource.MustParse("4"),
+				api.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			Allocatable: api.ResourceList{
+				api.ResourceCPU: resource.MustParse("3500m"),
+			},
+			Conditions: []api.NodeCondition{
+				{
+					Type:   api.NodeReady,
+					Status: api.ConditionTrue,
+					Reason: "KubeletReady",
+				},
+			},
+		},
+	}
+	expected := skres.Node{
+		Name:          "node-1",
+		Unschedulable: true,
+		Status: skres.NodeStatus{
+			Capacity: map[string]string{
+				"cpu":    "4",
+				"memory": "16Gi",
+			},
+			Allocatable: map[string]string{
+				"cpu": "3500m",
+			},
+			Conditions: []skres.NodeCondition{
+				{
+					Type:   api.NodeReady,
+					Status: api.ConditionTrue,
+					Reason: "KubeletReady",
+				},
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeNode))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			Node().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			Node().
+			Get("node-1")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			Node().
+			Get("node-1").
+			DataHandler(func(res interface{}) error {
+				node := res.(*api.Node)
+				node.Spec.Unschedulable = false
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.False(t, result.Unschedulable)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			Node().
+			Get("node-1").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestNodeList(t *testing.T) {
+	node1 := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+			Labels: map[string]string{
+				"pool": "gpu",
+				"some": "label",
+			},
+		},
+	}
+	node2 := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-2",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(node1, node2))
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			Node().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			Node().
+			List().
+			FilterByLabels(map[string]string{
+				"pool": "gpu",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestNodeDelete(t *testing.T) {
+	node := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(node)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			Node().
+			Delete("node-1")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "nodes"))
+	})
+}
+
+func TestNodeCordon(t *testing.T) {
+	node := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+	}
+
+	t.Run("should mark the node as unschedulable", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(node)
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.ClusterQuery().Node().Cordon("node-1").Run()
+		assert.Nil(t, err)
+
+		result, err := client.ClusterQuery().Node().Get("node-1").Run()
+		assert.Nil(t, err)
+		assert.True(t, result.Unschedulable)
+	})
+	t.Run("should mark the node as schedulable again", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(node)
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.ClusterQuery().Node().Cordon("node-1").Run()
+		assert.Nil(t, err)
+		err = client.ClusterQuery().Node().Uncordon("node-1").Run()
+		assert.Nil(t, err)
+
+		result, err := client.ClusterQuery().Node().Get("node-1").Run()
+		assert.Nil(t, err)
+		assert.False(t, result.Unschedulable)
+	})
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.ClusterQuery().Node().Cordon("not-found").Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+}
+
+func TestNodeDrain(t *testing.T) {
+	isController := true
+	node := &api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+	}
+	newPod := func(name, nodeName string) *api.Pod {
+		return &api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: api.PodSpec{
+				NodeName: nodeName,
+			},
+		}
+	}
+	web := newPod("web", "node-1")
+	db := newPod("db", "node-1")
+	other := newPod("other", "node-2")
+	agent := newPod("agent", "node-1")
+	agent.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "DaemonSet",
+			Name:       "agent",
+			Controller: &isController,
+		},
+	}
+	evictions := func(k8s *fake.Clientset) []string {
+		var res []string
+		for _, action := range k8s.Actions() {
+			if action.Matches("create", "pods") && action.GetSubresource() == "eviction" {
+				obj := action.(clienttesting.CreateAction).GetObject()
+				res = append(res, obj.(*policy.Eviction).Name)
+			}
+		}
+		return res
+	}
+
+	t.Run("should cordon the node and evict its pods", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(node, web, db, other, agent)
+		client := sk.NewClient(context.Background(), k8s)
+
+		result, err := client.ClusterQuery().
+			Node().
+			Drain("node-1", skres.DrainOptions{}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"web", "db"}, evictions(k8s))
+		assert.Equal(t, 2, len(result.Evicted))
+		assert.Equal(
+			t,
+			[]skres.PodReference{{Name: "agent", Namespace: "default"}},
+			result.Skipped,
+		)
+		assert.Empty(t, result.Failed)
+
+		cordoned, err := client.ClusterQuery().Node().Get("node-1").Run()
+		assert.Nil(t, err)
+		assert.True(t, cordoned.Unschedulable)
+	})
+	t.Run("should evict daemonset pods when requested", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(node, web, agent)
+		client := sk.NewClient(context.Background(), k8s)
+
+		result, err := client.ClusterQuery().
+			Node().
+			Drain("node-1", skres.DrainOptions{EvictDaemonSets: true}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"web", "agent"}, evictions(k8s))
+		assert.Empty(t, result.Skipped)
+	})
+	t.Run("should report pods protected by a disruption budget", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(node, web, db)
+		k8s.PrependReactor(
+			"create",
+			"pods",
+			func(action clienttesting.Action) (bool, k8sruntime.Object, error) {
+				if action.GetSubresource() != "eviction" {
+					return false, nil, nil
+				}
+				eviction := action.(clienttesting.CreateAction).GetObject().(*policy.Eviction)
+				if eviction.Name != "db" {
+					return false, nil, nil
+				}
+				return true, nil, kerrors.NewTooManyRequests("cannot evict pod", 10)
+			},
+		)
+		client := sk.NewClient(context.Background(), k8s)
+
+		result, err := client.ClusterQuery().
+			Node().
+			Drain("node-1", skres.DrainOptions{}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(
+			t,
+			[]skres.PodReference{{Name: "web", Namespace: "default"}},
+			result.Evicted,
+		)
+		assert.Equal(t, 1, len(result.Failed))
+		assert.Equal(t, "db", result.Failed[0].Pod.Name)
+		assert.Contains(t, result.Failed[0].Reason, "disruption budget")
+	})
+}
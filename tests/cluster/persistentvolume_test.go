@@ -0,0 +1,332 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPersistentVolumeCreate(t *testing.T) {
+	new := skres.PersistentVolume{
+		Name:          "nfs-share",
+		Capacity:      "100Gi",
+		AccessModes:   []api.PersistentVolumeAccessMode{api.ReadWriteMany},
+		ReclaimPolicy: api.PersistentVolumeReclaimRetain,
+		StorageClass:  "nfs",
+		ClaimRef: &skres.ClaimReference{
+			Name:      "shared-data",
+			Namespace: "default",
+		},
+		NFS: &skres.NFSVolumeSource{
+			Server: "10.0.0.10",
+			Path:   "/exports/share",
+		},
+		Status: skres.PersistentVolumeStatus{
+			Phase: api.VolumeBound,
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolume](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PersistentVolume().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolume](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PersistentVolume().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.PersistentVolume)
+					storage := obj.Spec.Capacity[api.ResourceStorage]
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "100Gi", storage.String())
+					assert.Equal(t, new.AccessModes, obj.Spec.AccessModes)
+					assert.Equal(t, "shared-data", obj.Spec.ClaimRef.Name)
+					assert.Equal(t, "10.0.0.10", obj.Spec.NFS.Server)
+					assert.Nil(t, obj.Spec.HostPath)
+					assert.Nil(t, obj.Spec.CSI)
+					assert.Empty(t, obj.Status.Phase)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestPersistentVolumeUpdate(t *testing.T) {
+	old := &api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nfs-share",
+		},
+		Spec: api.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: api.PersistentVolumeReclaimRetain,
+		},
+	}
+	new := skres.PersistentVolume{
+		Name:          "nfs-share",
+		ReclaimPolicy: api.PersistentVolumeReclaimDelete,
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolume](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PersistentVolume().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolume](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PersistentVolume().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.PersistentVolume)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, api.PersistentVolumeReclaimDelete, obj.Spec.PersistentVolumeReclaimPolicy)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestPersistentVolumeGet(t *testing.T) {
+	mode := api.PersistentVolumeBlock
+	kubePersistentVolume := &api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pv-123",
+		},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse("10Gi"),
+			},
+			AccessModes:                   []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			PersistentVolumeReclaimPolicy: api.PersistentVolumeReclaimDelete,
+			StorageClassName:              "standard",
+			VolumeMode:                    &mode,
+			ClaimRef: &api.ObjectReference{
+				Kind:      "PersistentVolumeClaim",
+				Name:      "my-claim",
+				Namespace: "default",
+				UID:       "1234",
+			},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				CSI: &api.CSIPersistentVolumeSource{
+					Driver:       "pd.csi.storage.gke.io",
+					VolumeHandle: "projects/p/zones/z/disks/pv-123",
+					FSType:       "ext4",
+				},
+			},
+		},
+		Status: api.PersistentVolumeStatus{
+			Phase: api.VolumeBound,
+		},
+	}
+	expected := skres.PersistentVolume{
+		Name:          "pv-123",
+		Capacity:      "10Gi",
+		AccessModes:   []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+		ReclaimPolicy: api.PersistentVolumeReclaimDelete,
+		StorageClass:  "standard",
+		VolumeMode:    api.PersistentVolumeBlock,
+		ClaimRef: &skres.ClaimReference{
+			Name:      "my-claim",
+			Namespace: "default",
+		},
+		CSI: &skres.CSIVolumeSource{
+			Driver: "pd.csi.storage.gke.io",
+			Handle: "projects/p/zones/z/disks/pv-123",
+			FSType: "ext4",
+		},
+		Status: skres.PersistentVolumeStatus{
+			Phase: api.VolumeBound,
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubePersistentVolume))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Get("pv-123")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Get("pv-123").
+			DataHandler(func(res interface{}) error {
+				volume := res.(*api.PersistentVolume)
+				volume.Status.Phase = api.VolumeReleased
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, api.VolumeReleased, result.Status.Phase)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Get("pv-123").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestPersistentVolumeList(t *testing.T) {
+	volume1 := &api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pv-123",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	volume2 := &api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pv-1232",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(volume1, volume2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PersistentVolume().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PersistentVolume().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestPersistentVolumeDelete(t *testing.T) {
+	volume := &api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pv-123",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(volume)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			PersistentVolume().
+			Delete("pv-123")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "persistentvolumes"))
+	})
+}
@@ -0,0 +1,279 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	api "k8s.io/api/core/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPriorityClassCreate(t *testing.T) {
+	new := skres.PriorityClass{
+		Name:             "batch-low",
+		Value:            -100,
+		Description:      "best effort batch workloads",
+		PreemptionPolicy: api.PreemptNever,
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PriorityClass](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PriorityClass().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.PriorityClass](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PriorityClass().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*scheduling.PriorityClass)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, int32(-100), obj.Value)
+					assert.Equal(t, new.Description, obj.Description)
+					assert.Equal(t, api.PreemptNever, *obj.PreemptionPolicy)
+					assert.False(t, obj.GlobalDefault)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			PriorityClass().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestPriorityClassUpdate(t *testing.T) {
+	old := &scheduling.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "batch-low",
+		},
+		Value: -100,
+	}
+	new := skres.PriorityClass{
+		Name:          "batch-low",
+		Value:         -100,
+		GlobalDefault: true,
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PriorityClass](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PriorityClass().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.PriorityClass](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				PriorityClass().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*scheduling.PriorityClass)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.True(t, obj.GlobalDefault)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			PriorityClass().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestPriorityClassGet(t *testing.T) {
+	preemption := api.PreemptLowerPriority
+	kubePriorityClass := &scheduling.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "critical",
+		},
+		Value:            1000000,
+		Description:      "platform components",
+		PreemptionPolicy: &preemption,
+	}
+	expected := skres.PriorityClass{
+		Name:             "critical",
+		Value:            1000000,
+		Description:      "platform components",
+		PreemptionPolicy: api.PreemptLowerPriority,
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubePriorityClass))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PriorityClass().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PriorityClass().
+			Get("critical")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PriorityClass().
+			Get("critical").
+			DataHandler(func(res interface{}) error {
+				class := res.(*scheduling.PriorityClass)
+				class.Value = 10
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 10, result.Value)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PriorityClass().
+			Get("critical").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestPriorityClassList(t *testing.T) {
+	class1 := &scheduling.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "critical",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	class2 := &scheduling.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "critical2",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(class1, class2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PriorityClass().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			PriorityClass().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestPriorityClassDelete(t *testing.T) {
+	class := &scheduling.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "critical",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(class)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			PriorityClass().
+			Delete("critical")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "priorityclasses"))
+	})
+}
@@ -0,0 +1,293 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	api "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStorageClassCreate(t *testing.T) {
+	new := skres.StorageClass{
+		Name:        "fast",
+		Provisioner: "ebs.csi.aws.com",
+		Parameters: map[string]string{
+			"type": "gp3",
+		},
+		ReclaimPolicy:  api.PersistentVolumeReclaimRetain,
+		BindingMode:    storage.VolumeBindingWaitForFirstConsumer,
+		AllowExpansion: true,
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.StorageClass](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				StorageClass().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.StorageClass](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				StorageClass().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*storage.StorageClass)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, new.Provisioner, obj.Provisioner)
+					assert.Equal(t, new.Parameters, obj.Parameters)
+					assert.Equal(t, api.PersistentVolumeReclaimRetain, *obj.ReclaimPolicy)
+					assert.Equal(t, storage.VolumeBindingWaitForFirstConsumer, *obj.VolumeBindingMode)
+					assert.True(t, *obj.AllowVolumeExpansion)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			StorageClass().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestStorageClassUpdate(t *testing.T) {
+	old := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fast",
+		},
+		Provisioner: "ebs.csi.aws.com",
+	}
+	new := skres.StorageClass{
+		Name:           "fast",
+		Provisioner:    "ebs.csi.aws.com",
+		AllowExpansion: true,
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.StorageClass](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				StorageClass().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.StorageClass](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				StorageClass().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*storage.StorageClass)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.True(t, *obj.AllowVolumeExpansion)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			StorageClass().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestStorageClassGet(t *testing.T) {
+	reclaim := api.PersistentVolumeReclaimDelete
+	binding := storage.VolumeBindingImmediate
+	kubeStorageClass := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "standard",
+			Annotations: map[string]string{
+				"storageclass.kubernetes.io/is-default-class": "true",
+			},
+		},
+		Provisioner:       "pd.csi.storage.gke.io",
+		ReclaimPolicy:     &reclaim,
+		VolumeBindingMode: &binding,
+		MountOptions:      []string{"noatime"},
+	}
+	expected := skres.StorageClass{
+		Name: "standard",
+		Annotations: map[string]string{
+			"storageclass.kubernetes.io/is-default-class": "true",
+		},
+		Provisioner:   "pd.csi.storage.gke.io",
+		ReclaimPolicy: api.PersistentVolumeReclaimDelete,
+		BindingMode:   storage.VolumeBindingImmediate,
+		MountOptions:  []string{"noatime"},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeStorageClass))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			StorageClass().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			StorageClass().
+			Get("standard")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			StorageClass().
+			Get("standard").
+			DataHandler(func(res interface{}) error {
+				class := res.(*storage.StorageClass)
+				class.Provisioner = "other"
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "other", result.Provisioner)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			StorageClass().
+			Get("standard").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestStorageClassList(t *testing.T) {
+	class1 := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "standard",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	class2 := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "standard2",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(class1, class2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			StorageClass().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			StorageClass().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestStorageClassDelete(t *testing.T) {
+	class := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "standard",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(class)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			StorageClass().
+			Delete("standard")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "storageclasses"))
+	})
+}
@@ -0,0 +1,331 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/base"
+	skres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	admission "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestValidatingWebhookConfigurationCreate(t *testing.T) {
+	new := skres.ValidatingWebhookConfiguration{
+		Name: "my-webhook",
+		Webhooks: []skres.ValidatingWebhook{
+			{
+				Name: "pods.my-webhook.example.com",
+				ClientConfig: skres.WebhookClientConfig{
+					Service: &skres.WebhookService{
+						Name:      "my-webhook",
+						Namespace: "kube-system",
+						Path:      "/validate",
+						Port:      8443,
+					},
+					CABundle: []byte("ca"),
+				},
+				Rules: []skres.WebhookRule{
+					{
+						Operations:  []admission.OperationType{admission.Create, admission.Update},
+						APIGroups:   []string{""},
+						APIVersions: []string{"v1"},
+						Resources:   []string{"pods"},
+						Scope:       admission.NamespacedScope,
+					},
+				},
+				FailurePolicy:           admission.Fail,
+				SideEffects:             admission.SideEffectClassNone,
+				TimeoutSeconds:          5,
+				AdmissionReviewVersions: []string{"v1"},
+				NamespaceSelector: &base.LabelSelector{
+					MatchLabels: map[string]string{"webhooks": "enabled"},
+				},
+			},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ValidatingWebhookConfiguration](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ValidatingWebhookConfiguration().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.ValidatingWebhookConfiguration](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ValidatingWebhookConfiguration().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*admission.ValidatingWebhookConfiguration)
+					assert.Equal(t, new.Name, obj.Name)
+					hook := obj.Webhooks[0]
+					assert.Equal(t, "pods.my-webhook.example.com", hook.Name)
+					assert.Equal(t, "my-webhook", hook.ClientConfig.Service.Name)
+					assert.Equal(t, "/validate", *hook.ClientConfig.Service.Path)
+					assert.Equal(t, int32(8443), *hook.ClientConfig.Service.Port)
+					assert.Equal(t, []byte("ca"), hook.ClientConfig.CABundle)
+					assert.Nil(t, hook.ClientConfig.URL)
+					assert.Equal(t, []admission.OperationType{admission.Create, admission.Update}, hook.Rules[0].Operations)
+					assert.Equal(t, []string{"pods"}, hook.Rules[0].Resources)
+					assert.Equal(t, admission.NamespacedScope, *hook.Rules[0].Scope)
+					assert.Equal(t, admission.Fail, *hook.FailurePolicy)
+					assert.Equal(t, admission.SideEffectClassNone, *hook.SideEffects)
+					assert.Equal(t, int32(5), *hook.TimeoutSeconds)
+					assert.Equal(t, "enabled", hook.NamespaceSelector.MatchLabels["webhooks"])
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestValidatingWebhookConfigurationUpdate(t *testing.T) {
+	old := &admission.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-webhook",
+		},
+	}
+	url := "https://webhook.example.com/validate"
+	new := skres.ValidatingWebhookConfiguration{
+		Name: "my-webhook",
+		Webhooks: []skres.ValidatingWebhook{
+			{
+				Name:         "pods.my-webhook.example.com",
+				ClientConfig: skres.WebhookClientConfig{URL: url},
+			},
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ValidatingWebhookConfiguration](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ValidatingWebhookConfiguration().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.ValidatingWebhookConfiguration](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.ClusterQuery().
+				ValidatingWebhookConfiguration().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*admission.ValidatingWebhookConfiguration)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, url, *obj.Webhooks[0].ClientConfig.URL)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestValidatingWebhookConfigurationGet(t *testing.T) {
+	url := "https://webhook.example.com/validate"
+	policy := admission.Ignore
+	kubeValidatingWebhookConfiguration := &admission.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-webhook",
+		},
+		Webhooks: []admission.ValidatingWebhook{
+			{
+				Name:                    "pods.my-webhook.example.com",
+				ClientConfig:            admission.WebhookClientConfig{URL: &url},
+				FailurePolicy:           &policy,
+				AdmissionReviewVersions: []string{"v1"},
+			},
+		},
+	}
+	expected := skres.ValidatingWebhookConfiguration{
+		Name: "my-webhook",
+		Webhooks: []skres.ValidatingWebhook{
+			{
+				Name:                    "pods.my-webhook.example.com",
+				ClientConfig:            skres.WebhookClientConfig{URL: url},
+				FailurePolicy:           admission.Ignore,
+				AdmissionReviewVersions: []string{"v1"},
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeValidatingWebhookConfiguration))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Get("my-webhook")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Get("my-webhook").
+			DataHandler(func(res interface{}) error {
+				config := res.(*admission.ValidatingWebhookConfiguration)
+				config.Webhooks = nil
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result.Webhooks)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Get("my-webhook").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestValidatingWebhookConfigurationList(t *testing.T) {
+	config1 := &admission.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-webhook",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	config2 := &admission.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-webhook2",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(config1, config2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestValidatingWebhookConfigurationDelete(t *testing.T) {
+	config := &admission.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-webhook",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(config)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.ClusterQuery().
+			ValidatingWebhookConfiguration().
+			Delete("my-webhook")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "validatingwebhookconfigurations"))
+	})
+}
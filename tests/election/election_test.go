@@ -0,0 +1,359 @@
+package election_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/election"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	coordination "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var start = time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+type recorder struct {
+	mu      sync.Mutex
+	started chan context.Context
+	stopped chan struct{}
+	leaders []string
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		started: make(chan context.Context, 1),
+		stopped: make(chan struct{}, 1),
+	}
+}
+
+func (r *recorder) callbacks() election.Callbacks {
+	return election.Callbacks{
+		OnStartedLeading: func(ctx context.Context) { r.started <- ctx },
+		OnStoppedLeading: func() { r.stopped <- struct{}{} },
+		OnNewLeader: func(identity string) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.leaders = append(r.leaders, identity)
+		},
+	}
+}
+
+func (r *recorder) newLeaders() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.leaders...)
+}
+
+func newElector(
+	t *testing.T,
+	k8s *fake.Clientset,
+	clock *kt.FakeClock,
+	identity string,
+	callbacks election.Callbacks,
+) *election.Elector {
+	client := sk.NewClient(context.Background(), k8s)
+	elector, err := election.New(client.NamespacedQuery("default"), election.Config{
+		Name:          "my-controller",
+		Identity:      identity,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+		Callbacks:     callbacks,
+		Clock:         clock,
+	})
+	assert.Nil(t, err)
+	return elector
+}
+
+func getLease(t *testing.T, k8s *fake.Clientset) *coordination.Lease {
+	lease, err := k8s.CoordinationV1().
+		Leases("default").
+		Get(context.Background(), "my-controller", metav1.GetOptions{})
+	assert.Nil(t, err)
+	return lease
+}
+
+// step waits for the elector to block on the clock before moving it forward
+func step(t *testing.T, clock *kt.FakeClock, d time.Duration) {
+	assert.Eventually(t, func() bool {
+		return clock.Waiters() > 0
+	}, 5*time.Second, time.Millisecond)
+	clock.Step(d)
+}
+
+func TestNew(t *testing.T) {
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+	query := client.NamespacedQuery("default")
+	callbacks := newRecorder().callbacks()
+
+	t.Run("should apply defaults", func(t *testing.T) {
+		_, err := election.New(query, election.Config{
+			Name:      "my-controller",
+			Identity:  "pod-a",
+			Callbacks: callbacks,
+		})
+
+		assert.Nil(t, err)
+	})
+	t.Run("should require name and identity", func(t *testing.T) {
+		_, err := election.New(query, election.Config{
+			Identity:  "pod-a",
+			Callbacks: callbacks,
+		})
+		assert.Equal(t, "lease name is required", err.Error())
+
+		_, err = election.New(query, election.Config{
+			Name:      "my-controller",
+			Callbacks: callbacks,
+		})
+		assert.Equal(t, "identity is required", err.Error())
+	})
+	t.Run("should validate timings", func(t *testing.T) {
+		_, err := election.New(query, election.Config{
+			Name:          "my-controller",
+			Identity:      "pod-a",
+			LeaseDuration: 10 * time.Second,
+			RenewDeadline: 10 * time.Second,
+			Callbacks:     callbacks,
+		})
+		assert.Equal(t, "lease duration must be greater than renew deadline", err.Error())
+
+		_, err = election.New(query, election.Config{
+			Name:          "my-controller",
+			Identity:      "pod-a",
+			RenewDeadline: 5 * time.Second,
+			RetryPeriod:   5 * time.Second,
+			Callbacks:     callbacks,
+		})
+		assert.Equal(t, "renew deadline must be greater than retry period", err.Error())
+	})
+	t.Run("should require leading callbacks", func(t *testing.T) {
+		_, err := election.New(query, election.Config{
+			Name:     "my-controller",
+			Identity: "pod-a",
+		})
+
+		assert.Equal(t, "OnStartedLeading callback is required", err.Error())
+	})
+}
+
+func TestTryAcquireOrRenew(t *testing.T) {
+	t.Run("should create the lease when missing", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		elector := newElector(t, k8s, clock, "pod-a", newRecorder().callbacks())
+
+		ok, err := elector.TryAcquireOrRenew()
+
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.True(t, elector.IsLeader())
+		lease := getLease(t, k8s)
+		assert.Equal(t, "pod-a", *lease.Spec.HolderIdentity)
+		assert.Equal(t, int32(15), *lease.Spec.LeaseDurationSeconds)
+		assert.True(t, lease.Spec.AcquireTime.Time.Equal(start))
+	})
+	t.Run("should renew a held lease", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		elector := newElector(t, k8s, clock, "pod-a", newRecorder().callbacks())
+
+		_, err := elector.TryAcquireOrRenew()
+		assert.Nil(t, err)
+		clock.Step(2 * time.Second)
+		ok, err := elector.TryAcquireOrRenew()
+
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		lease := getLease(t, k8s)
+		assert.True(t, lease.Spec.AcquireTime.Time.Equal(start))
+		assert.True(t, lease.Spec.RenewTime.Time.Equal(start.Add(2*time.Second)))
+		assert.Nil(t, lease.Spec.LeaseTransitions)
+	})
+	t.Run("should send the observed resource version on update", func(t *testing.T) {
+		holder := "pod-a"
+		k8s := fake.NewSimpleClientset(&coordination.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "my-controller",
+				Namespace:       "default",
+				ResourceVersion: "42",
+			},
+			Spec: coordination.LeaseSpec{HolderIdentity: &holder},
+		})
+		clock := kt.NewFakeClock(start)
+		elector := newElector(t, k8s, clock, "pod-a", newRecorder().callbacks())
+
+		ok, err := elector.TryAcquireOrRenew()
+
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		update := k8s.Actions()[1].(clienttesting.UpdateAction)
+		assert.Equal(t, "42", update.GetObject().(*coordination.Lease).ResourceVersion)
+	})
+	t.Run("should take over only after the lease expires", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		recorder := newRecorder()
+		leader := newElector(t, k8s, clock, "pod-a", newRecorder().callbacks())
+		candidate := newElector(t, k8s, clock, "pod-b", recorder.callbacks())
+
+		_, err := leader.TryAcquireOrRenew()
+		assert.Nil(t, err)
+		ok, err := candidate.TryAcquireOrRenew()
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, "pod-a", candidate.Leader())
+
+		clock.Step(14 * time.Second)
+		ok, _ = candidate.TryAcquireOrRenew()
+		assert.False(t, ok)
+
+		clock.Step(time.Second)
+		ok, err = candidate.TryAcquireOrRenew()
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"pod-a", "pod-b"}, recorder.newLeaders())
+		lease := getLease(t, k8s)
+		assert.Equal(t, "pod-b", *lease.Spec.HolderIdentity)
+		assert.Equal(t, int32(1), *lease.Spec.LeaseTransitions)
+		assert.True(t, lease.Spec.AcquireTime.Time.Equal(start.Add(15*time.Second)))
+
+		ok, err = leader.TryAcquireOrRenew()
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		assert.False(t, leader.IsLeader())
+	})
+	t.Run("should return api errors", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		k8s.PrependReactor("get", "leases", func(clienttesting.Action) (bool, k8sruntime.Object, error) {
+			return true, nil, errors.New("api unavailable")
+		})
+		clock := kt.NewFakeClock(start)
+		elector := newElector(t, k8s, clock, "pod-a", newRecorder().callbacks())
+
+		ok, err := elector.TryAcquireOrRenew()
+
+		assert.False(t, ok)
+		assert.Equal(t, "api unavailable", err.Error())
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("should step down when another candidate takes the lease", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		recorder := newRecorder()
+		elector := newElector(t, k8s, clock, "pod-a", recorder.callbacks())
+		done := make(chan struct{})
+
+		go func() {
+			elector.Run(context.Background())
+			close(done)
+		}()
+		leaderCtx := <-recorder.started
+
+		lease := getLease(t, k8s)
+		holder := "pod-b"
+		renew := metav1.NewMicroTime(start.Add(time.Second))
+		lease.Spec.HolderIdentity = &holder
+		lease.Spec.RenewTime = &renew
+		_, err := k8s.CoordinationV1().
+			Leases("default").
+			Update(context.Background(), lease, metav1.UpdateOptions{})
+		assert.Nil(t, err)
+		step(t, clock, 2*time.Second)
+
+		<-recorder.stopped
+		<-done
+		assert.NotNil(t, leaderCtx.Err())
+		assert.Equal(t, "pod-b", elector.Leader())
+	})
+	t.Run("should step down after the renew deadline", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		recorder := newRecorder()
+		elector := newElector(t, k8s, clock, "pod-a", recorder.callbacks())
+		done := make(chan struct{})
+
+		go func() {
+			elector.Run(context.Background())
+			close(done)
+		}()
+		<-recorder.started
+		k8s.PrependReactor("update", "leases", func(clienttesting.Action) (bool, k8sruntime.Object, error) {
+			return true, nil, errors.New("api unavailable")
+		})
+
+		for i := 0; i < 4; i++ {
+			step(t, clock, 2*time.Second)
+		}
+		select {
+		case <-done:
+			t.Fatal("stepped down before the renew deadline")
+		case <-time.After(50 * time.Millisecond):
+		}
+		step(t, clock, 2*time.Second)
+
+		<-recorder.stopped
+		<-done
+	})
+	t.Run("should release the lease on cancel", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		recorder := newRecorder()
+		client := sk.NewClient(context.Background(), k8s)
+		elector, err := election.New(client.NamespacedQuery("default"), election.Config{
+			Name:            "my-controller",
+			Identity:        "pod-a",
+			ReleaseOnCancel: true,
+			Callbacks:       recorder.callbacks(),
+			Clock:           clock,
+		})
+		assert.Nil(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+
+		go func() {
+			elector.Run(ctx)
+			close(done)
+		}()
+		<-recorder.started
+		cancel()
+
+		<-recorder.stopped
+		<-done
+		lease := getLease(t, k8s)
+		assert.Nil(t, lease.Spec.HolderIdentity)
+		assert.False(t, elector.IsLeader())
+	})
+	t.Run("should wait for the lease while another candidate holds it", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		clock := kt.NewFakeClock(start)
+		holder := newElector(t, k8s, clock, "pod-b", newRecorder().callbacks())
+		_, err := holder.TryAcquireOrRenew()
+		assert.Nil(t, err)
+		recorder := newRecorder()
+		elector := newElector(t, k8s, clock, "pod-a", recorder.callbacks())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go elector.Run(ctx)
+		for i := 0; i < 7; i++ {
+			step(t, clock, 2*time.Second)
+			assert.Equal(t, 0, len(recorder.started))
+		}
+		step(t, clock, 2*time.Second)
+
+		<-recorder.started
+		assert.True(t, elector.IsLeader())
+	})
+}
@@ -8,9 +8,7 @@ import (
 	"testing"
 	"time"
 
-	skcl "github.com/ilexPar/simple-kube/pkg/cluster"
 	skclres "github.com/ilexPar/simple-kube/pkg/cluster/resources"
-	skns "github.com/ilexPar/simple-kube/pkg/namespaced"
 	sknsres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
 
 	"github.com/stretchr/testify/assert"
@@ -29,11 +27,8 @@ const (
 	Update
 )
 
-type k8sResources interface {
-	skns.NamespacedResourcesConstrain | skcl.ClusterResourcesConstrain
-}
-
-func WithInformedClient[T k8sResources](
+// WithInformedClient accepts any resource with a case in resolveInformerByDef
+func WithInformedClient[T any](
 	t *testing.T,
 	action informedActions,
 	exec func(client *fake.Clientset),
@@ -148,6 +143,26 @@ func resolveInformerByDef(def interface{}, i informers.SharedInformerFactory) ca
 		return i.Core().V1().ConfigMaps().Informer()
 	case skclres.Namespace:
 		return i.Core().V1().Namespaces().Informer()
+	case skclres.ClusterRole:
+		return i.Rbac().V1().ClusterRoles().Informer()
+	case skclres.ClusterRoleBinding:
+		return i.Rbac().V1().ClusterRoleBindings().Informer()
+	case skclres.Node:
+		return i.Core().V1().Nodes().Informer()
+	case skclres.StorageClass:
+		return i.Storage().V1().StorageClasses().Informer()
+	case skclres.PersistentVolume:
+		return i.Core().V1().PersistentVolumes().Informer()
+	case skclres.PriorityClass:
+		return i.Scheduling().V1().PriorityClasses().Informer()
+	case skclres.IngressClass:
+		return i.Networking().V1().IngressClasses().Informer()
+	case skclres.ValidatingWebhookConfiguration:
+		return i.Admissionregistration().V1().ValidatingWebhookConfigurations().Informer()
+	case skclres.MutatingWebhookConfiguration:
+		return i.Admissionregistration().V1().MutatingWebhookConfigurations().Informer()
+	case skclres.CertificateSigningRequest:
+		return i.Certificates().V1().CertificateSigningRequests().Informer()
 	case sknsres.Service:
 		return i.Core().V1().Services().Informer()
 	case sknsres.Job:
@@ -158,6 +173,36 @@ func resolveInformerByDef(def interface{}, i informers.SharedInformerFactory) ca
 		return i.Networking().V1().Ingresses().Informer()
 	case sknsres.HPA:
 		return i.Autoscaling().V2().HorizontalPodAutoscalers().Informer()
+	case sknsres.StatefulSet:
+		return i.Apps().V1().StatefulSets().Informer()
+	case sknsres.DaemonSet:
+		return i.Apps().V1().DaemonSets().Informer()
+	case sknsres.Secret:
+		return i.Core().V1().Secrets().Informer()
+	case sknsres.Pod:
+		return i.Core().V1().Pods().Informer()
+	case sknsres.PersistentVolumeClaim:
+		return i.Core().V1().PersistentVolumeClaims().Informer()
+	case sknsres.ServiceAccount:
+		return i.Core().V1().ServiceAccounts().Informer()
+	case sknsres.Role:
+		return i.Rbac().V1().Roles().Informer()
+	case sknsres.RoleBinding:
+		return i.Rbac().V1().RoleBindings().Informer()
+	case sknsres.NetworkPolicy:
+		return i.Networking().V1().NetworkPolicies().Informer()
+	case sknsres.PodDisruptionBudget:
+		return i.Policy().V1().PodDisruptionBudgets().Informer()
+	case sknsres.ResourceQuota:
+		return i.Core().V1().ResourceQuotas().Informer()
+	case sknsres.LimitRange:
+		return i.Core().V1().LimitRanges().Informer()
+	case sknsres.Event:
+		return i.Core().V1().Events().Informer()
+	case sknsres.ReplicaSet:
+		return i.Apps().V1().ReplicaSets().Informer()
+	case sknsres.Lease:
+		return i.Coordination().V1().Leases().Informer()
 	default:
 		t := reflect.ValueOf(def).Type().Name()
 		err := fmt.Sprintf("no case provided for %s", t)
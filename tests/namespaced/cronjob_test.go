@@ -373,3 +373,47 @@ func TestCronJobDelete(t *testing.T) {
 		assert.True(t, k8s.Actions()[0].Matches("delete", "cronjobs"))
 	})
 }
+
+func TestCronJobInitContainers(t *testing.T) {
+	new := skres.CronJob{
+		Name:     "my-cron",
+		Schedule: "0 * * * *",
+		InitContainers: []skres.Container{
+			{
+				Name:    "migrate",
+				Image:   "sarasa",
+				Command: []string{"sarasa", "migrate"},
+			},
+		},
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+	}
+
+	t.Run("should load the same init containers back", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.NamespacedQuery("default").
+			CronJob().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				init := res.(*batch.CronJob).Spec.JobTemplate.Spec.Template.Spec.InitContainers
+				assert.Equal(t, "migrate", init[0].Name)
+				return nil
+			}).
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.NamespacedQuery("default").
+			CronJob().
+			Get("my-cron").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.InitContainers, result.InitContainers)
+	})
+}
@@ -0,0 +1,279 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/base"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skns "github.com/ilexPar/simple-kube/pkg/namespaced"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type Certificate struct {
+	skres.CustomResource
+	Name       string            `sm:"metadata.name"`
+	Labels     map[string]string `sm:"metadata.labels"`
+	SecretName string            `sm:"spec.secretName"`
+	DNSNames   []string          `sm:"spec.dnsNames"`
+	Issuer     string            `sm:"spec.issuerRef.name"`
+}
+
+var certificateResource = base.DynamicResource{
+	Resource: schema.GroupVersionResource{
+		Group:    "cert-manager.io",
+		Version:  "v1",
+		Resource: "certificates",
+	},
+	Kind: "Certificate",
+}
+
+func newCertificate(name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{
+				"secretName": name + "-tls",
+				"dnsNames":   []interface{}{"example.com"},
+				"issuerRef": map[string]interface{}{
+					"name": "letsencrypt",
+				},
+			},
+		},
+	}
+	obj.SetLabels(labels)
+	return obj
+}
+
+func newDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			certificateResource.Resource: "CertificateList",
+		},
+		objects...,
+	)
+}
+
+func certificates(dyn *dynamicfake.FakeDynamicClient) skns.NamespacedAction[Certificate] {
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset()).
+		WithDynamicClient(dyn)
+	return skns.Custom[Certificate](
+		client.NamespacedQuery("default"),
+		certificateResource,
+	)
+}
+
+func TestCustomResourceCreate(t *testing.T) {
+	new := Certificate{
+		Name:       "my-cert",
+		SecretName: "my-cert-tls",
+		DNSNames:   []string{"example.com", "www.example.com"},
+		Issuer:     "letsencrypt",
+	}
+
+	t.Run("should create the unstructured object", func(t *testing.T) {
+		dyn := newDynamicClient()
+
+		err := certificates(dyn).Create(new).Run()
+
+		assert.Nil(t, err)
+		obj, err := dyn.Resource(certificateResource.Resource).
+			Namespace("default").
+			Get(context.Background(), "my-cert", metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "cert-manager.io/v1", obj.GetAPIVersion())
+		assert.Equal(t, "Certificate", obj.GetKind())
+		secret, _, _ := unstructured.NestedString(obj.Object, "spec", "secretName")
+		assert.Equal(t, "my-cert-tls", secret)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		dyn := newDynamicClient()
+		hasCallbackRun := false
+
+		err := certificates(dyn).
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				obj := res.(*unstructured.Unstructured)
+				assert.Equal(t, new.Name, obj.GetName())
+				assert.Equal(t, 0, len(dyn.Actions()))
+				hasCallbackRun = true
+				return nil
+			}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.True(t, hasCallbackRun)
+		assert.Equal(t, 1, len(dyn.Actions()))
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		dyn := newDynamicClient()
+
+		err := certificates(dyn).
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			}).
+			Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(dyn.Actions()))
+	})
+	t.Run("should fail without a dynamic client", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+		query := skns.Custom[Certificate](
+			client.NamespacedQuery("default"),
+			certificateResource,
+		)
+
+		err := query.Create(new).Run()
+
+		assert.Equal(t, skerr.ERROR_NO_DYNAMIC_CLIENT, err.Error())
+	})
+}
+
+func TestCustomResourceUpdate(t *testing.T) {
+	new := Certificate{
+		Name:       "my-cert",
+		SecretName: "rotated-tls",
+		Issuer:     "letsencrypt",
+	}
+
+	t.Run("should update the unstructured object", func(t *testing.T) {
+		old := newCertificate("my-cert", nil)
+		old.SetResourceVersion("42")
+		dyn := newDynamicClient(old)
+
+		err := certificates(dyn).
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				obj := res.(*unstructured.Unstructured)
+				assert.Empty(t, obj.GetResourceVersion())
+				return nil
+			}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.True(t, dyn.Actions()[0].Matches("get", "certificates"))
+		assert.True(t, dyn.Actions()[1].Matches("update", "certificates"))
+		obj, _ := dyn.Resource(certificateResource.Resource).
+			Namespace("default").
+			Get(context.Background(), "my-cert", metav1.GetOptions{})
+		secret, _, _ := unstructured.NestedString(obj.Object, "spec", "secretName")
+		assert.Equal(t, "rotated-tls", secret)
+	})
+	t.Run("should fail when the object doesn't exist", func(t *testing.T) {
+		dyn := newDynamicClient()
+
+		err := certificates(dyn).Update(new).Run()
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, len(dyn.Actions()))
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		dyn := newDynamicClient(newCertificate("my-cert", nil))
+
+		err := certificates(dyn).
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			}).
+			Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(dyn.Actions()))
+	})
+}
+
+func TestCustomResourceGet(t *testing.T) {
+	expected := Certificate{
+		Name:       "my-cert",
+		SecretName: "my-cert-tls",
+		DNSNames:   []string{"example.com"},
+		Issuer:     "letsencrypt",
+	}
+	query := certificates(newDynamicClient(newCertificate("my-cert", nil)))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		_, err := query.Get("not-found").Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		result, err := query.Get("my-cert").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		result, err := query.Get("my-cert").
+			DataHandler(func(res interface{}) error {
+				obj := res.(*unstructured.Unstructured)
+				return unstructured.SetNestedField(obj.Object, "other", "spec", "issuerRef", "name")
+			}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "other", result.Issuer)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		_, err := query.Get("my-cert").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			}).
+			Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestCustomResourceList(t *testing.T) {
+	query := certificates(newDynamicClient(
+		newCertificate("my-cert", map[string]string{"app": "nginx", "some": "label"}),
+		newCertificate("my-cert2", map[string]string{"some": "label"}),
+	))
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		result, err := query.List().Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		result, err := query.List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+		assert.Equal(t, "my-cert", result[0].Name)
+	})
+}
+
+func TestCustomResourceDelete(t *testing.T) {
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		dyn := newDynamicClient(newCertificate("my-cert", nil))
+
+		err := certificates(dyn).Delete("my-cert").Run()
+
+		assert.Nil(t, err)
+		assert.True(t, dyn.Actions()[0].Matches("delete", "certificates"))
+	})
+}
@@ -0,0 +1,422 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDaemonSetCreate(t *testing.T) {
+	maxUnavailable := intstr.FromString("10%")
+	new := skres.DaemonSet{
+		Name: "log-shipper",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "fluent-bit",
+			},
+		},
+		Scheduling: skres.PodScheduling{
+			Tolerations: []skres.Toleration{
+				{
+					Key:      "node-role.kubernetes.io/control-plane",
+					Operator: v1.TolerationOpExists,
+					Effect:   v1.TaintEffectNoSchedule,
+				},
+			},
+		},
+		Strategy: skres.DaemonSetStrategy{
+			Type:           apps.RollingUpdateDaemonSetStrategyType,
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.DaemonSet](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				DaemonSet().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.DaemonSet](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				DaemonSet().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*apps.DaemonSet)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(
+						t,
+						apps.RollingUpdateDaemonSetStrategyType,
+						obj.Spec.UpdateStrategy.Type,
+					)
+					assert.Equal(
+						t,
+						"10%",
+						obj.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.String(),
+					)
+					assert.Equal(t, v1.TaintEffectNoSchedule, obj.Spec.Template.Spec.Tolerations[0].Effect)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+}
+
+func TestDaemonSetUpdate(t *testing.T) {
+	old := &apps.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "log-shipper",
+			Namespace: "default",
+		},
+		Spec: apps.DaemonSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: "fluent-bit",
+						},
+					},
+				},
+			},
+		},
+	}
+	new := skres.DaemonSet{
+		Name: "log-shipper",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "fluent-bit2",
+			},
+		},
+		Strategy: skres.DaemonSetStrategy{
+			Type: apps.OnDeleteDaemonSetStrategyType,
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.DaemonSet](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				DaemonSet().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.DaemonSet](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				DaemonSet().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*apps.DaemonSet)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, apps.OnDeleteDaemonSetStrategyType, obj.Spec.UpdateStrategy.Type)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestDaemonSetGet(t *testing.T) {
+	maxSurge := intstr.FromInt32(1)
+	kubeDaemonSet := &apps.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "log-shipper",
+			Namespace: "default",
+		},
+		Spec: apps.DaemonSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: "fluent-bit",
+						},
+					},
+				},
+			},
+			UpdateStrategy: apps.DaemonSetUpdateStrategy{
+				Type: apps.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &apps.RollingUpdateDaemonSet{
+					MaxSurge: &maxSurge,
+				},
+			},
+		},
+	}
+	expected := skres.DaemonSet{
+		Name: "log-shipper",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "fluent-bit",
+			},
+		},
+		Strategy: skres.DaemonSetStrategy{
+			Type:     apps.RollingUpdateDaemonSetStrategyType,
+			MaxSurge: &maxSurge,
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeDaemonSet))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Get("log-shipper")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Get("log-shipper").
+			DataHandler(func(res interface{}) error {
+				ds := res.(*apps.DaemonSet)
+				ds.Spec.Template.Spec.Containers[0].Image = "overrided"
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "overrided", result.Containers[0].Image)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Get("log-shipper").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestDaemonSetList(t *testing.T) {
+	ds1 := &apps.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "log-shipper",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "fluent-bit",
+				"some": "label",
+			},
+		},
+	}
+	ds2 := &apps.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node-exporter",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(ds1, ds2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "fluent-bit",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestDaemonSetDelete(t *testing.T) {
+	ds := &apps.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "log-shipper",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(ds)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			DaemonSet().
+			Delete("log-shipper")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "daemonsets"))
+	})
+}
+
+func TestDaemonSetSurgeStrategy(t *testing.T) {
+	maxUnavailable := intstr.FromInt32(0)
+	maxSurge := intstr.FromInt32(1)
+	new := skres.DaemonSet{
+		Name: "log-shipper",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "fluent-bit",
+			},
+		},
+		Strategy: skres.DaemonSetStrategy{
+			Type:           apps.RollingUpdateDaemonSetStrategyType,
+			MaxUnavailable: &maxUnavailable,
+			MaxSurge:       &maxSurge,
+		},
+	}
+
+	t.Run("should keep an explicit zero max unavailable", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.NamespacedQuery("default").
+			DaemonSet().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				rolling := res.(*apps.DaemonSet).Spec.UpdateStrategy.RollingUpdate
+				assert.NotNil(t, rolling.MaxUnavailable)
+				assert.Equal(t, 0, rolling.MaxUnavailable.IntValue())
+				assert.Equal(t, 1, rolling.MaxSurge.IntValue())
+				return nil
+			}).
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.NamespacedQuery("default").
+			DaemonSet().
+			Get("log-shipper").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Strategy, result.Strategy)
+	})
+	t.Run("should leave unset fields nil", func(t *testing.T) {
+		unset := new
+		unset.Strategy = skres.DaemonSetStrategy{
+			Type:     apps.RollingUpdateDaemonSetStrategyType,
+			MaxSurge: &maxSurge,
+		}
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.NamespacedQuery("default").
+			DaemonSet().
+			Create(unset).
+			DataHandler(func(res interface{}) error {
+				rolling := res.(*apps.DaemonSet).Spec.UpdateStrategy.RollingUpdate
+				assert.Nil(t, rolling.MaxUnavailable)
+				return nil
+			}).
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.NamespacedQuery("default").
+			DaemonSet().
+			Get("log-shipper").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Nil(t, result.Strategy.MaxUnavailable)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	sk "github.com/ilexPar/simple-kube/pkg"
 	skerr "github.com/ilexPar/simple-kube/pkg/errors"
@@ -14,6 +15,8 @@ import (
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -346,3 +349,685 @@ func TestDeploymentDelete(t *testing.T) {
 		assert.True(t, k8s.Actions()[0].Matches("delete", "deployments"))
 	})
 }
+
+func TestDeploymentVolumes(t *testing.T) {
+	new := skres.Deployment{
+		Name: "my-deployment",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+				VolumeMounts: []skres.VolumeMount{
+					{Name: "data", Path: "/data"},
+					{Name: "config", Path: "/etc/app", ReadOnly: true},
+					{Name: "creds", Path: "/etc/creds", ReadOnly: true},
+					{Name: "tmp", Path: "/tmp"},
+				},
+			},
+		},
+		Volumes: []skres.Volume{
+			{Name: "data", PVC: "my-claim"},
+			{Name: "config", ConfigMap: "my-config"},
+			{Name: "creds", Secret: "my-secret"},
+			{Name: "tmp", EmptyDir: &skres.EmptyDirVolume{Medium: v1.StorageMediumMemory}},
+		},
+	}
+
+	t.Run("should dump volume sources and mounts", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				spec := res.(*apps.Deployment).Spec.Template.Spec
+				assert.Equal(t, "my-claim", spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+				assert.Equal(t, "my-config", spec.Volumes[1].ConfigMap.Name)
+				assert.Equal(t, "my-secret", spec.Volumes[2].Secret.SecretName)
+				assert.Equal(t, v1.StorageMediumMemory, spec.Volumes[3].EmptyDir.Medium)
+				assert.Equal(t, "/etc/app", spec.Containers[0].VolumeMounts[1].MountPath)
+				assert.True(t, spec.Containers[0].VolumeMounts[1].ReadOnly)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should load the same volumes back", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.NamespacedQuery("default").
+			Deployment().
+			Get("my-deployment").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+	})
+}
+
+func TestDeploymentContainerResources(t *testing.T) {
+	new := skres.Deployment{
+		Name: "my-deployment",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+				Resources: skres.ContainerResources{
+					Requests: map[string]string{
+						"cpu":               "250m",
+						"memory":            "256Mi",
+						"ephemeral-storage": "1Gi",
+					},
+					Limits: map[string]string{
+						"cpu":            "1",
+						"memory":         "512Mi",
+						"nvidia.com/gpu": "1",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("should dump requests and limits", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				resources := res.(*apps.Deployment).Spec.Template.Spec.Containers[0].Resources
+				assert.Equal(t, "250m", resources.Requests.Cpu().String())
+				assert.Equal(t, "256Mi", resources.Requests.Memory().String())
+				assert.Equal(t, "1Gi", resources.Requests.StorageEphemeral().String())
+				assert.Equal(t, "1", resources.Limits.Cpu().String())
+				assert.Equal(t, "512Mi", resources.Limits.Memory().String())
+				gpu := resources.Limits[v1.ResourceName("nvidia.com/gpu")]
+				assert.Equal(t, "1", gpu.String())
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should keep requests and limits through get and update", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		deployments := client.NamespacedQuery("default").Deployment()
+
+		err := deployments.Create(new).Run()
+		assert.Nil(t, err)
+		result, err := deployments.Get("my-deployment").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+
+		result.Containers[0].Image = "sarasa:2"
+		err = deployments.Update(result).Run()
+		assert.Nil(t, err)
+		updated, err := deployments.Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Containers[0].Resources, updated.Containers[0].Resources)
+	})
+}
+
+func TestDeploymentProbes(t *testing.T) {
+	new := skres.Deployment{
+		Name: "my-deployment",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+				Liveness: &skres.Probe{
+					HTTPGet: &skres.HTTPGetAction{
+						Path:   "/healthz",
+						Port:   intstr.FromString("http"),
+						Scheme: v1.URISchemeHTTP,
+						Headers: []skres.HTTPHeader{
+							{Name: "X-Probe", Value: "liveness"},
+						},
+					},
+					InitialDelaySeconds: 10,
+					PeriodSeconds:       20,
+					TimeoutSeconds:      2,
+					FailureThreshold:    3,
+				},
+				Readiness: &skres.Probe{
+					TCPSocket: &skres.TCPSocketAction{
+						Port: intstr.FromInt32(5432),
+					},
+					PeriodSeconds:    5,
+					SuccessThreshold: 2,
+				},
+				Startup: &skres.Probe{
+					Exec:             []string{"cat", "/tmp/ready"},
+					PeriodSeconds:    1,
+					FailureThreshold: 30,
+				},
+			},
+			{
+				Name:  "sidecar",
+				Image: "sarasa-grpc",
+				Liveness: &skres.Probe{
+					GRPC: &skres.GRPCAction{
+						Port:    9090,
+						Service: "health",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("should dump every probe handler", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				containers := res.(*apps.Deployment).Spec.Template.Spec.Containers
+				liveness := containers[0].LivenessProbe
+				assert.Equal(t, "/healthz", liveness.HTTPGet.Path)
+				assert.Equal(t, "http", liveness.HTTPGet.Port.String())
+				assert.Equal(t, "liveness", liveness.HTTPGet.HTTPHeaders[0].Value)
+				assert.Equal(t, int32(10), liveness.InitialDelaySeconds)
+				assert.Equal(t, int32(3), liveness.FailureThreshold)
+				readiness := containers[0].ReadinessProbe
+				assert.Equal(t, 5432, readiness.TCPSocket.Port.IntValue())
+				assert.Nil(t, readiness.HTTPGet)
+				assert.Equal(t, int32(2), readiness.SuccessThreshold)
+				startup := containers[0].StartupProbe
+				assert.Equal(t, []string{"cat", "/tmp/ready"}, startup.Exec.Command)
+				assert.Equal(t, int32(30), startup.FailureThreshold)
+				grpc := containers[1].LivenessProbe.GRPC
+				assert.Equal(t, int32(9090), grpc.Port)
+				assert.Equal(t, "health", *grpc.Service)
+				assert.Nil(t, containers[1].ReadinessProbe)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should keep probes through get and update", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		deployments := client.NamespacedQuery("default").Deployment()
+
+		err := deployments.Create(new).Run()
+		assert.Nil(t, err)
+		result, err := deployments.Get("my-deployment").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+
+		result.Containers[0].Image = "sarasa:2"
+		err = deployments.Update(result).Run()
+		assert.Nil(t, err)
+		updated, err := deployments.Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Containers[0].Liveness, updated.Containers[0].Liveness)
+		assert.Equal(t, new.Containers[0].Readiness, updated.Containers[0].Readiness)
+		assert.Equal(t, new.Containers[0].Startup, updated.Containers[0].Startup)
+		assert.Equal(t, new.Containers[1].Liveness, updated.Containers[1].Liveness)
+	})
+}
+
+func TestDeploymentEnv(t *testing.T) {
+	new := skres.Deployment{
+		Name: "my-deployment",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+				Env: []skres.EnvVar{
+					{Name: "MODE", Value: "production"},
+					{
+						Name:      "LOG_LEVEL",
+						ConfigMap: &skres.KeySelector{Name: "my-config", Key: "log-level"},
+					},
+					{
+						Name:   "DB_PASSWORD",
+						Secret: &skres.KeySelector{Name: "my-secret", Key: "password", Optional: true},
+					},
+					{Name: "POD_NAME", Field: "metadata.name"},
+					{
+						Name: "MEMORY_LIMIT",
+						ResourceField: &skres.ResourceFieldSelector{
+							Container: "main",
+							Resource:  "limits.memory",
+							Divisor:   "1Mi",
+						},
+					},
+				},
+				EnvFrom: []skres.EnvFromSource{
+					{ConfigMap: "my-config"},
+					{Prefix: "DB_", Secret: "my-secret"},
+				},
+			},
+		},
+	}
+
+	t.Run("should dump value sources and imports", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				container := res.(*apps.Deployment).Spec.Template.Spec.Containers[0]
+				assert.Equal(t, "production", container.Env[0].Value)
+				assert.Nil(t, container.Env[0].ValueFrom)
+				assert.Equal(t, "my-config", container.Env[1].ValueFrom.ConfigMapKeyRef.Name)
+				assert.Equal(t, "log-level", container.Env[1].ValueFrom.ConfigMapKeyRef.Key)
+				assert.Equal(t, "password", container.Env[2].ValueFrom.SecretKeyRef.Key)
+				assert.True(t, *container.Env[2].ValueFrom.SecretKeyRef.Optional)
+				assert.Equal(t, "metadata.name", container.Env[3].ValueFrom.FieldRef.FieldPath)
+				assert.Equal(t, "limits.memory", container.Env[4].ValueFrom.ResourceFieldRef.Resource)
+				assert.Equal(t, "1Mi", container.Env[4].ValueFrom.ResourceFieldRef.Divisor.String())
+				assert.Equal(t, "my-config", container.EnvFrom[0].ConfigMapRef.Name)
+				assert.Nil(t, container.EnvFrom[0].SecretRef)
+				assert.Equal(t, "DB_", container.EnvFrom[1].Prefix)
+				assert.Equal(t, "my-secret", container.EnvFrom[1].SecretRef.Name)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should keep env through get and update", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		deployments := client.NamespacedQuery("default").Deployment()
+
+		err := deployments.Create(new).Run()
+		assert.Nil(t, err)
+		result, err := deployments.Get("my-deployment").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+
+		result.Containers[0].Image = "sarasa:2"
+		err = deployments.Update(result).Run()
+		assert.Nil(t, err)
+		updated, err := deployments.Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Containers[0].Env, updated.Containers[0].Env)
+		assert.Equal(t, new.Containers[0].EnvFrom, updated.Containers[0].EnvFrom)
+	})
+}
+
+func TestDeploymentInitContainers(t *testing.T) {
+	new := skres.Deployment{
+		Name: "my-deployment",
+		InitContainers: []skres.Container{
+			{
+				Name:    "migrate",
+				Image:   "sarasa",
+				Command: []string{"sarasa", "migrate"},
+			},
+			{
+				Name:          "log-forwarder",
+				Image:         "fluent-bit",
+				RestartPolicy: v1.ContainerRestartPolicyAlways,
+			},
+		},
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+	}
+
+	t.Run("should dump init and sidecar containers", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				spec := res.(*apps.Deployment).Spec.Template.Spec
+				assert.Equal(t, 2, len(spec.InitContainers))
+				assert.Equal(t, []string{"sarasa", "migrate"}, spec.InitContainers[0].Command)
+				assert.Nil(t, spec.InitContainers[0].RestartPolicy)
+				assert.Equal(t, v1.ContainerRestartPolicyAlways, *spec.InitContainers[1].RestartPolicy)
+				assert.Equal(t, "main", spec.Containers[0].Name)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should keep init containers through get and update", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		deployments := client.NamespacedQuery("default").Deployment()
+
+		err := deployments.Create(new).Run()
+		assert.Nil(t, err)
+		result, err := deployments.Get("my-deployment").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+
+		result.Containers[0].Image = "sarasa:2"
+		err = deployments.Update(result).Run()
+		assert.Nil(t, err)
+		updated, err := deployments.Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.InitContainers, updated.InitContainers)
+	})
+}
+
+func TestDeploymentScheduling(t *testing.T) {
+	new := skres.Deployment{
+		Name: "my-deployment",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+		NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+		Scheduling: skres.PodScheduling{
+			Tolerations: []skres.Toleration{
+				{
+					Key:      "dedicated",
+					Operator: v1.TolerationOpEqual,
+					Value:    "batch",
+					Effect:   v1.TaintEffectNoSchedule,
+				},
+			},
+			NodeAffinity: &skres.NodeAffinity{
+				Required: []skres.NodeSelectorTerm{
+					{
+						MatchExpressions: []skres.NodeSelectorRequirement{
+							{
+								Key:      "topology.kubernetes.io/zone",
+								Operator: v1.NodeSelectorOpIn,
+								Values:   []string{"us-east-1a", "us-east-1b"},
+							},
+						},
+					},
+				},
+				Preferred: []skres.PreferredNodeSelectorTerm{
+					{
+						Weight: 50,
+						Term: skres.NodeSelectorTerm{
+							MatchExpressions: []skres.NodeSelectorRequirement{
+								{Key: "spot", Operator: v1.NodeSelectorOpDoesNotExist},
+							},
+						},
+					},
+				},
+			},
+			PodAffinity: &skres.PodAffinity{
+				Preferred: []skres.WeightedPodAffinityTerm{
+					{
+						Weight: 10,
+						Term: skres.PodAffinityTerm{
+							Selector: &skres.LabelSelector{
+								MatchLabels: map[string]string{"app": "cache"},
+							},
+							TopologyKey: "kubernetes.io/hostname",
+						},
+					},
+				},
+			},
+			PodAntiAffinity: &skres.PodAffinity{
+				Required: []skres.PodAffinityTerm{
+					{
+						Selector: &skres.LabelSelector{
+							MatchLabels: map[string]string{"app": "sarasa"},
+						},
+						TopologyKey: "kubernetes.io/hostname",
+					},
+				},
+			},
+			TopologySpread: []skres.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: v1.ScheduleAnyway,
+					Selector: &skres.LabelSelector{
+						MatchLabels: map[string]string{"app": "sarasa"},
+					},
+					MatchLabelKeys: []string{"pod-template-hash"},
+				},
+			},
+		},
+	}
+
+	t.Run("should dump scheduling controls", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Deployment().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				spec := res.(*apps.Deployment).Spec.Template.Spec
+				assert.Equal(t, "linux", spec.NodeSelector["kubernetes.io/os"])
+				assert.Equal(t, "batch", spec.Tolerations[0].Value)
+				required := spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+				assert.Equal(t, []string{"us-east-1a", "us-east-1b"}, required.NodeSelectorTerms[0].MatchExpressions[0].Values)
+				preferred := spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+				assert.Equal(t, int32(50), preferred[0].Weight)
+				assert.Equal(t, v1.NodeSelectorOpDoesNotExist, preferred[0].Preference.MatchExpressions[0].Operator)
+				affinity := spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+				assert.Equal(t, "cache", affinity[0].PodAffinityTerm.LabelSelector.MatchLabels["app"])
+				antiAffinity := spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+				assert.Equal(t, "kubernetes.io/hostname", antiAffinity[0].TopologyKey)
+				spread := spec.TopologySpreadConstraints[0]
+				assert.Equal(t, int32(1), spread.MaxSkew)
+				assert.Equal(t, v1.ScheduleAnyway, spread.WhenUnsatisfiable)
+				assert.Equal(t, []string{"pod-template-hash"}, spread.MatchLabelKeys)
+				assert.Nil(t, spread.MinDomains)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should keep scheduling controls through get and update", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		deployments := client.NamespacedQuery("default").Deployment()
+
+		err := deployments.Create(new).Run()
+		assert.Nil(t, err)
+		result, err := deployments.Get("my-deployment").Run()
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+
+		result.Containers[0].Image = "sarasa:2"
+		err = deployments.Update(result).Run()
+		assert.Nil(t, err)
+		updated, err := deployments.Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Scheduling, updated.Scheduling)
+	})
+}
+
+func newRolloutReplicaSet(
+	deployment *apps.Deployment,
+	revision, image, cause string,
+	created time.Time,
+) *apps.ReplicaSet {
+	return &apps.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name + "-" + revision,
+			Namespace: deployment.Namespace,
+			Labels: map[string]string{
+				"app":                                "nginx",
+				apps.DefaultDeploymentUniqueLabelKey: revision,
+			},
+			Annotations: map[string]string{
+				"deployment.kubernetes.io/revision": revision,
+				"kubernetes.io/change-cause":        cause,
+			},
+			CreationTimestamp: metav1.NewTime(created),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(deployment, apps.SchemeGroupVersion.WithKind("Deployment")),
+			},
+		},
+		Spec: apps.ReplicaSetSpec{
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app":                                "nginx",
+						apps.DefaultDeploymentUniqueLabelKey: revision,
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: image,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newRolloutFixtures() []runtime.Object {
+	created := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	deployment := &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment",
+			Namespace: "default",
+			UID:       "deployment-uid",
+		},
+		Spec: apps.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "nginx"},
+			},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "nginx"},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: "nginx:1.27",
+						},
+					},
+				},
+			},
+		},
+	}
+	orphan := newRolloutReplicaSet(deployment, "9", "nginx:0.1", "", created)
+	orphan.Name = "orphan"
+	orphan.OwnerReferences = nil
+	return []runtime.Object{
+		deployment,
+		newRolloutReplicaSet(deployment, "3", "nginx:1.27", "upgrade to 1.27", created.Add(2*time.Hour)),
+		newRolloutReplicaSet(deployment, "1", "nginx:1.25", "initial", created),
+		newRolloutReplicaSet(deployment, "2", "nginx:1.26", "upgrade to 1.26", created.Add(time.Hour)),
+		orphan,
+	}
+}
+
+func TestDeploymentHistory(t *testing.T) {
+	t.Run("should list revisions from oldest to newest", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(newRolloutFixtures()...))
+
+		result, err := client.NamespacedQuery("default").
+			Deployment().
+			History("my-deployment").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(result))
+		assert.Equal(t, skres.DeploymentRevision{
+			Revision:    1,
+			ReplicaSet:  "my-deployment-1",
+			Images:      []string{"nginx:1.25"},
+			ChangeCause: "initial",
+			Created:     skres.NewTimestamp(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)),
+		}, result[0])
+		assert.Equal(t, int64(2), result[1].Revision)
+		assert.Equal(t, int64(3), result[2].Revision)
+		assert.Equal(t, "upgrade to 1.27", result[2].ChangeCause)
+	})
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+
+		_, err := client.NamespacedQuery("default").
+			Deployment().
+			History("not-found").
+			Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+}
+
+func TestDeploymentUndo(t *testing.T) {
+	t.Run("should roll back to the previous revision", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(newRolloutFixtures()...))
+		query := client.NamespacedQuery("default").Deployment()
+
+		err := query.Undo("my-deployment", 0).Run()
+		assert.Nil(t, err)
+		result, err := query.Get("my-deployment").
+			DataHandler(func(res interface{}) error {
+				obj := res.(*apps.Deployment)
+				assert.NotContains(t, obj.Spec.Template.Labels, apps.DefaultDeploymentUniqueLabelKey)
+				return nil
+			}).
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "nginx:1.26", result.Containers[0].Image)
+		assert.Equal(t, map[string]string{"app": "nginx"}, result.TemplateLabels)
+	})
+	t.Run("should roll back to a given revision", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(newRolloutFixtures()...))
+		query := client.NamespacedQuery("default").Deployment()
+
+		err := query.Undo("my-deployment", 1).Run()
+		assert.Nil(t, err)
+		result, err := query.Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "nginx:1.25", result.Containers[0].Image)
+	})
+	t.Run("should fail on unknown revisions", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(newRolloutFixtures()...))
+
+		err := client.NamespacedQuery("default").
+			Deployment().
+			Undo("my-deployment", 9).
+			Run()
+
+		assert.Equal(t, "revision 9 not found for deployment my-deployment", err.Error())
+	})
+	t.Run("should fail without previous revisions", func(t *testing.T) {
+		fixtures := newRolloutFixtures()
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset(fixtures[0], fixtures[1]))
+
+		err := client.NamespacedQuery("default").
+			Deployment().
+			Undo("my-deployment", 0).
+			Run()
+
+		assert.Equal(t, "no previous revision found for deployment my-deployment", err.Error())
+	})
+}
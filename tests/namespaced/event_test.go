@@ -0,0 +1,296 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newEvent(name, kind, object, reason string, last time.Time) *api.Event {
+	return &api.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		InvolvedObject: api.ObjectReference{
+			Kind:      kind,
+			Name:      object,
+			Namespace: "default",
+		},
+		Type:           api.EventTypeWarning,
+		Reason:         reason,
+		Count:          1,
+		FirstTimestamp: metav1.NewTime(last),
+		LastTimestamp:  metav1.NewTime(last),
+	}
+}
+
+func TestEventCreate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	new := skres.Event{
+		Name:    "my-deployment.1",
+		Type:    api.EventTypeNormal,
+		Reason:  "ScalingReplicaSet",
+		Message: "Scaled up replica set my-deployment-abc to 1",
+		Object: skres.ObjectReference{
+			Kind: "Deployment",
+			Name: "my-deployment",
+		},
+		Source:         "deployment-controller",
+		Count:          1,
+		FirstTimestamp: skres.NewTimestamp(now),
+		LastTimestamp:  skres.NewTimestamp(now),
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Event](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Event().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.Event](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Event().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Event)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "Deployment", obj.InvolvedObject.Kind)
+					assert.Equal(t, "deployment-controller", obj.Source.Component)
+					assert.True(t, now.Equal(obj.LastTimestamp.Time))
+					assert.True(t, obj.EventTime.IsZero())
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should dump event time as micro time", func(t *testing.T) {
+		event := new
+		event.EventTime = skres.NewTimestamp(now.Add(1500 * time.Microsecond))
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Event().
+			Create(event).
+			DataHandler(func(res interface{}) error {
+				obj := res.(*api.Event)
+				assert.True(t, event.EventTime.Equal(obj.EventTime.Time))
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Event().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestEventGet(t *testing.T) {
+	first := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	last := first.Add(5 * time.Minute)
+	kubeEvent := newEvent("my-pod.1", "Pod", "my-pod", "BackOff", last)
+	kubeEvent.FirstTimestamp = metav1.NewTime(first)
+	kubeEvent.Message = "Back-off restarting failed container"
+	kubeEvent.Count = 12
+	expected := skres.Event{
+		Name:    "my-pod.1",
+		Type:    api.EventTypeWarning,
+		Reason:  "BackOff",
+		Message: "Back-off restarting failed container",
+		Object: skres.ObjectReference{
+			Kind:      "Pod",
+			Name:      "my-pod",
+			Namespace: "default",
+		},
+		Count:          12,
+		FirstTimestamp: skres.NewTimestamp(first),
+		LastTimestamp:  skres.NewTimestamp(last),
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeEvent))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Event().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Event().
+			Get("my-pod.1")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+		assert.Equal(t, last, result.Time())
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Event().
+			Get("my-pod.1").
+			DataHandler(func(res interface{}) error {
+				event := res.(*api.Event)
+				event.Count = 13
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 13, result.Count)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Event().
+			Get("my-pod.1").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestEventList(t *testing.T) {
+	now := time.Now()
+	event1 := newEvent("my-pod.1", "Pod", "my-pod", "Pulled", now)
+	event1.Labels = map[string]string{"app": "nginx"}
+	event2 := newEvent("my-pod.2", "Pod", "my-pod", "Started", now)
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(event1, event2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Event().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Event().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestEventDelete(t *testing.T) {
+	event := newEvent("my-pod.1", "Pod", "my-pod", "Pulled", time.Now())
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(event)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Event().
+			Delete("my-pod.1")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "events"))
+	})
+}
+
+func TestEventInvolving(t *testing.T) {
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	scheduled := newEvent("my-pod.2", "Pod", "my-pod", "Scheduled", start)
+	pulling := newEvent("my-pod.3", "Pod", "my-pod", "Pulling", start.Add(time.Second))
+	failed := newEvent("my-pod.1", "Pod", "my-pod", "Failed", start.Add(time.Minute))
+	// events.k8s.io events don't set lastTimestamp
+	failed.LastTimestamp = metav1.Time{}
+	failed.FirstTimestamp = metav1.Time{}
+	failed.EventTime = metav1.NewMicroTime(start.Add(time.Minute))
+	other := newEvent("other.1", "Pod", "other", "Scheduled", start)
+	sameName := newEvent("my-pod.4", "Deployment", "my-pod", "ScalingReplicaSet", start)
+
+	t.Run("should return object events sorted by time", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(failed, pulling, scheduled, other, sameName)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Event().
+			Involving("Pod", "my-pod")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		reasons := []string{}
+		for _, event := range result {
+			reasons = append(reasons, event.Reason)
+		}
+		assert.Equal(t, []string{"Scheduled", "Pulling", "Failed"}, reasons)
+	})
+	t.Run("should query by involved object field selector", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Event().
+			Involving("Deployment", "my-deployment")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+		list := k8s.Actions()[0].(clienttesting.ListAction)
+		assert.Equal(
+			t,
+			"involvedObject.kind=Deployment,involvedObject.name=my-deployment",
+			list.GetListRestrictions().Fields.String(),
+		)
+	})
+}
@@ -0,0 +1,175 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	"github.com/ilexPar/simple-kube/pkg/base"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skns "github.com/ilexPar/simple-kube/pkg/namespaced"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+
+	sm "github.com/ilexPar/struct-marshal/pkg"
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// PodTemplate is defined the way a downstream package would plug in a
+// resource not shipped with the library
+type PodTemplate struct {
+	Name       string            `sm:"metadata.name"`
+	Labels     map[string]string `sm:"metadata.labels"`
+	Containers []skres.Container `sm:"template.spec.containers"`
+}
+
+func (pt PodTemplate) API() skres.NamespacedResourceAPI {
+	return &PodTemplateAPI{}
+}
+
+func (pt PodTemplate) Dump(from interface{}) (interface{}, error) {
+	res := &api.PodTemplate{}
+	err := sm.Marshal(from, res)
+	return res, err
+}
+
+func (pt PodTemplate) Load(from, into interface{}) error {
+	return sm.Unmarshal(from, into)
+}
+
+type PodTemplateAPI struct {
+	base.KubeAPI
+}
+
+func (pt *PodTemplateAPI) Get(name, namespace string) (interface{}, error) {
+	return pt.Client.CoreV1().
+		PodTemplates(namespace).
+		Get(pt.Context, name, metav1.GetOptions{})
+}
+
+func (pt *PodTemplateAPI) Create(namespace string, obj interface{}) error {
+	_, err := pt.Client.CoreV1().
+		PodTemplates(namespace).
+		Create(pt.Context, obj.(*api.PodTemplate), metav1.CreateOptions{})
+	return err
+}
+
+func (pt *PodTemplateAPI) Update(namespace string, obj interface{}) error {
+	_, err := pt.Client.CoreV1().
+		PodTemplates(namespace).
+		Update(pt.Context, obj.(*api.PodTemplate), metav1.UpdateOptions{})
+	return err
+}
+
+func (pt *PodTemplateAPI) List(namespace string) ([]interface{}, error) {
+	var res []interface{}
+	list, err := pt.Client.CoreV1().
+		PodTemplates(namespace).
+		List(pt.Context, pt.Opts.List)
+	for _, v := range list.Items {
+		res = append(res, v)
+	}
+	return res, err
+}
+
+func (pt *PodTemplateAPI) Delete(name, namespace string) error {
+	return pt.Client.CoreV1().
+		PodTemplates(namespace).
+		Delete(pt.Context, name, metav1.DeleteOptions{})
+}
+
+// wrappedQuery stands in for callers decorating the query interface
+type wrappedQuery struct {
+	skns.QueryNamespace
+}
+
+func TestForRegisteredResource(t *testing.T) {
+	template := PodTemplate{
+		Name:   "worker",
+		Labels: map[string]string{"app": "worker"},
+		Containers: []skres.Container{
+			{
+				Name:  "worker",
+				Image: "worker:1.0",
+			},
+		},
+	}
+
+	t.Run("should create and get the resource", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		templates := skns.For[PodTemplate](client.NamespacedQuery("default"))
+
+		err := templates.Create(template).Run()
+		assert.Nil(t, err)
+		result, err := templates.Get("worker").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, template, result)
+		assert.True(t, k8s.Actions()[0].Matches("create", "podtemplates"))
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		hasCallbackRun := false
+
+		err := skns.For[PodTemplate](client.NamespacedQuery("default")).
+			Create(template).
+			DataHandler(func(res interface{}) error {
+				obj := res.(*api.PodTemplate)
+				assert.Equal(t, "worker:1.0", obj.Template.Spec.Containers[0].Image)
+				hasCallbackRun = true
+				return errors.New("test error")
+			}).
+			Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.True(t, hasCallbackRun)
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+	t.Run("should update, list and delete the resource", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+		templates := skns.For[PodTemplate](client.NamespacedQuery("default"))
+		assert.Nil(t, templates.Create(template).Run())
+
+		updated := template
+		updated.Containers = []skres.Container{{Name: "worker", Image: "worker:2.0"}}
+		assert.Nil(t, templates.Update(updated).Run())
+		list, err := templates.List().
+			FilterByLabels(map[string]string{"app": "worker"}).
+			Run()
+		assert.Nil(t, err)
+		assert.Equal(t, []PodTemplate{updated}, list)
+
+		assert.Nil(t, templates.Delete("worker").Run())
+		_, err = templates.Get("worker").Run()
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should serve built in resources", func(t *testing.T) {
+		deployment := skres.Deployment{Name: "my-deployment"}
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+		query := client.NamespacedQuery("default")
+
+		err := skns.For[skres.Deployment](query).Create(deployment).Run()
+		assert.Nil(t, err)
+		result, err := query.Deployment().Get("my-deployment").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "my-deployment", result.Name)
+	})
+	t.Run("should work with a wrapped query", func(t *testing.T) {
+		client := sk.NewClient(context.Background(), fake.NewSimpleClientset())
+		query := wrappedQuery{client.NamespacedQuery("default")}
+		templates := skns.For[PodTemplate](query)
+
+		assert.Nil(t, templates.Create(template).Run())
+		result, err := templates.Get("worker").Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, template, result)
+	})
+}
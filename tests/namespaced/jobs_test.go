@@ -346,3 +346,40 @@ func TestJobDelete(t *testing.T) {
 		assert.True(t, k8s.Actions()[0].Matches("delete", "jobs"))
 	})
 }
+
+func TestJobInitContainers(t *testing.T) {
+	new := skres.Job{
+		Name: "my-job",
+		InitContainers: []skres.Container{
+			{
+				Name:          "log-forwarder",
+				Image:         "fluent-bit",
+				RestartPolicy: v1.ContainerRestartPolicyAlways,
+			},
+		},
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+	}
+
+	t.Run("should dump sidecar containers", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Job().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				init := res.(*batch.Job).Spec.Template.Spec.InitContainers
+				assert.Equal(t, "log-forwarder", init[0].Name)
+				assert.Equal(t, v1.ContainerRestartPolicyAlways, *init[0].RestartPolicy)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,294 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	coordination "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLeaseCreate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	new := skres.Lease{
+		Name:            "my-controller",
+		Holder:          "pod-a",
+		DurationSeconds: 15,
+		AcquireTime:     skres.NewTimestamp(now),
+		RenewTime:       skres.NewTimestamp(now.Add(1500 * time.Microsecond)),
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Lease](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Lease().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.Lease](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Lease().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*coordination.Lease)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "pod-a", *obj.Spec.HolderIdentity)
+					assert.Equal(t, int32(15), *obj.Spec.LeaseDurationSeconds)
+					assert.True(t, obj.Spec.RenewTime.Time.Equal(new.RenewTime.Time))
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Lease().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestLeaseUpdate(t *testing.T) {
+	holder := "pod-a"
+	old := &coordination.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-controller",
+			Namespace: "default",
+		},
+		Spec: coordination.LeaseSpec{
+			HolderIdentity: &holder,
+		},
+	}
+	new := skres.Lease{
+		Name:        "my-controller",
+		Holder:      "pod-b",
+		Transitions: 1,
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Lease](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Lease().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.Lease](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Lease().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*coordination.Lease)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "pod-b", *obj.Spec.HolderIdentity)
+					assert.Equal(t, int32(1), *obj.Spec.LeaseTransitions)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Lease().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestLeaseGet(t *testing.T) {
+	holder := "pod-a"
+	duration := int32(15)
+	transitions := int32(3)
+	renew := metav1.NewMicroTime(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC))
+	kubeLease := &coordination.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-controller",
+			Namespace: "default",
+		},
+		Spec: coordination.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &duration,
+			RenewTime:            &renew,
+			LeaseTransitions:     &transitions,
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeLease))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Lease().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Lease().
+			Get("my-controller")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "my-controller", result.Name)
+		assert.Equal(t, "pod-a", result.Holder)
+		assert.Equal(t, 15, result.DurationSeconds)
+		assert.Equal(t, 3, result.Transitions)
+		assert.True(t, result.RenewTime.Equal(renew.Time))
+		assert.True(t, result.AcquireTime.IsZero())
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Lease().
+			Get("my-controller").
+			DataHandler(func(res interface{}) error {
+				lease := res.(*coordination.Lease)
+				holder := "pod-b"
+				lease.Spec.HolderIdentity = &holder
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pod-b", result.Holder)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Lease().
+			Get("my-controller").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestLeaseList(t *testing.T) {
+	lease1 := &coordination.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-controller",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	lease2 := &coordination.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-controller2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(lease1, lease2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Lease().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Lease().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestLeaseDelete(t *testing.T) {
+	lease := &coordination.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-controller",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(lease)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Lease().
+			Delete("my-controller")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "leases"))
+	})
+}
@@ -0,0 +1,306 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLimitRangeCreate(t *testing.T) {
+	new := skres.LimitRange{
+		Name: "my-limits",
+		Limits: []skres.LimitRangeItem{
+			{
+				Type:           api.LimitTypeContainer,
+				Default:        map[string]string{"cpu": "500m", "memory": "512Mi"},
+				DefaultRequest: map[string]string{"cpu": "100m", "memory": "128Mi"},
+				Min:            map[string]string{"cpu": "50m"},
+				Max:            map[string]string{"cpu": "2", "memory": "4Gi"},
+			},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.LimitRange](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				LimitRange().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.LimitRange](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				LimitRange().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.LimitRange)
+					limit := obj.Spec.Limits[0]
+					cpu := limit.DefaultRequest[api.ResourceCPU]
+					memory := limit.Max[api.ResourceMemory]
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, api.LimitTypeContainer, limit.Type)
+					assert.Equal(t, "100m", cpu.String())
+					assert.Equal(t, "4Gi", memory.String())
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestLimitRangeUpdate(t *testing.T) {
+	old := &api.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-limits",
+			Namespace: "default",
+		},
+	}
+	new := skres.LimitRange{
+		Name: "my-limits",
+		Limits: []skres.LimitRangeItem{
+			{
+				Type: api.LimitTypeContainer,
+				Max:  map[string]string{"memory": "1Gi"},
+			},
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.LimitRange](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				LimitRange().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.LimitRange](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				LimitRange().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.LimitRange)
+					assert.Equal(t, 1, len(obj.Spec.Limits))
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestLimitRangeGet(t *testing.T) {
+	kubeLimits := &api.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-limits",
+			Namespace: "default",
+		},
+		Spec: api.LimitRangeSpec{
+			Limits: []api.LimitRangeItem{
+				{
+					Type: api.LimitTypeContainer,
+					Default: api.ResourceList{
+						api.ResourceCPU: resource.MustParse("500m"),
+					},
+					Max: api.ResourceList{
+						api.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		},
+	}
+	expected := skres.LimitRange{
+		Name: "my-limits",
+		Limits: []skres.LimitRangeItem{
+			{
+				Type:    api.LimitTypeContainer,
+				Default: map[string]string{"cpu": "500m"},
+				Max:     map[string]string{"memory": "1Gi"},
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeLimits))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Get("my-limits")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Get("my-limits").
+			DataHandler(func(res interface{}) error {
+				limits := res.(*api.LimitRange)
+				limits.Spec.Limits[0].Type = api.LimitTypePod
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, api.LimitTypePod, result.Limits[0].Type)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Get("my-limits").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestLimitRangeList(t *testing.T) {
+	limits1 := &api.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-limits",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	limits2 := &api.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-limits2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(limits1, limits2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestLimitRangeDelete(t *testing.T) {
+	limits := &api.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-limits",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(limits)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			LimitRange().
+			Delete("my-limits")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "limitranges"))
+	})
+}
@@ -0,0 +1,356 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	net "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNetworkPolicyCreate(t *testing.T) {
+	new := skres.NetworkPolicy{
+		Name:        "tenant-isolation",
+		PolicyTypes: []net.PolicyType{net.PolicyTypeIngress, net.PolicyTypeEgress},
+		Ingress: []skres.NetworkPolicyIngressRule{
+			{
+				From: []skres.NetworkPolicyPeer{
+					{
+						NamespaceSelector: &skres.LabelSelector{
+							MatchLabels: map[string]string{"tenant": "a"},
+						},
+					},
+				},
+			},
+		},
+		Egress: []skres.NetworkPolicyEgressRule{
+			{
+				To: []skres.NetworkPolicyPeer{
+					{
+						CIDR:   "10.0.0.0/8",
+						Except: []string{"10.1.0.0/16"},
+					},
+				},
+				Ports: []skres.NetworkPolicyPort{
+					{
+						Protocol: api.ProtocolTCP,
+						Port:     intstr.FromInt32(5432),
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.NetworkPolicy](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				NetworkPolicy().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.NetworkPolicy](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				NetworkPolicy().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*net.NetworkPolicy)
+					ingress := obj.Spec.Ingress[0].From[0]
+					egress := obj.Spec.Egress[0]
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Nil(t, ingress.PodSelector)
+					assert.Equal(t, "a", ingress.NamespaceSelector.MatchLabels["tenant"])
+					assert.Equal(t, "10.0.0.0/8", egress.To[0].IPBlock.CIDR)
+					assert.Equal(t, []string{"10.1.0.0/16"}, egress.To[0].IPBlock.Except)
+					assert.Equal(t, int32(5432), egress.Ports[0].Port.IntVal)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+	t.Run("should round trip rules", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Create(new).
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Get("tenant-isolation").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new, result)
+	})
+}
+
+func TestNetworkPolicyUpdate(t *testing.T) {
+	old := &net.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deny-all",
+			Namespace: "default",
+		},
+		Spec: net.NetworkPolicySpec{
+			PolicyTypes: []net.PolicyType{net.PolicyTypeIngress},
+		},
+	}
+	new := skres.NetworkPolicy{
+		Name:        "deny-all",
+		PolicyTypes: []net.PolicyType{net.PolicyTypeIngress, net.PolicyTypeEgress},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.NetworkPolicy](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				NetworkPolicy().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.NetworkPolicy](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				NetworkPolicy().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*net.NetworkPolicy)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestNetworkPolicyGet(t *testing.T) {
+	kubePolicy := &net.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "allow-web",
+			Namespace: "default",
+		},
+		Spec: net.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+			PolicyTypes: []net.PolicyType{net.PolicyTypeIngress},
+			Ingress: []net.NetworkPolicyIngressRule{
+				{
+					From: []net.NetworkPolicyPeer{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "proxy"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := skres.NetworkPolicy{
+		Name: "allow-web",
+		PodSelector: skres.LabelSelector{
+			MatchLabels: map[string]string{"app": "web"},
+		},
+		PolicyTypes: []net.PolicyType{net.PolicyTypeIngress},
+		Ingress: []skres.NetworkPolicyIngressRule{
+			{
+				From: []skres.NetworkPolicyPeer{
+					{
+						PodSelector: &skres.LabelSelector{
+							MatchLabels: map[string]string{"app": "proxy"},
+						},
+					},
+				},
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubePolicy))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Get("allow-web")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Get("allow-web").
+			DataHandler(func(res interface{}) error {
+				policy := res.(*net.NetworkPolicy)
+				policy.Spec.PodSelector.MatchLabels["app"] = "overrided"
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "overrided", result.PodSelector.MatchLabels["app"])
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Get("allow-web").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestNetworkPolicyList(t *testing.T) {
+	policy1 := &net.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "allow-web",
+			Namespace: "default",
+			Labels: map[string]string{
+				"tenant": "a",
+				"some":   "label",
+			},
+		},
+	}
+	policy2 := &net.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deny-all",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(policy1, policy2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			List().
+			FilterByLabels(map[string]string{
+				"tenant": "a",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestNetworkPolicyDelete(t *testing.T) {
+	policy := &net.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deny-all",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(policy)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			NetworkPolicy().
+			Delete("deny-all")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "networkpolicies"))
+	})
+}
@@ -0,0 +1,315 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPodDisruptionBudgetCreate(t *testing.T) {
+	deployment := skres.Deployment{
+		Name: "my-deployment",
+		ServiceSelector: map[string]string{
+			"app": "nginx",
+		},
+	}
+	minAvailable := intstr.FromString("50%")
+	new := skres.PodDisruptionBudget{
+		Name: deployment.Name,
+		Selector: skres.LabelSelector{
+			MatchLabels: deployment.ServiceSelector,
+		},
+		MinAvailable:               &minAvailable,
+		UnhealthyPodEvictionPolicy: policy.AlwaysAllow,
+		Status: skres.PodDisruptionBudgetStatus{
+			DisruptionsAllowed: 1,
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PodDisruptionBudget](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PodDisruptionBudget().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.PodDisruptionBudget](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PodDisruptionBudget().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*policy.PodDisruptionBudget)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "50%", obj.Spec.MinAvailable.String())
+					assert.Nil(t, obj.Spec.MaxUnavailable)
+					assert.Equal(t, deployment.ServiceSelector, obj.Spec.Selector.MatchLabels)
+					assert.Equal(t, int32(0), obj.Status.DisruptionsAllowed)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+}
+
+func TestPodDisruptionBudgetUpdate(t *testing.T) {
+	minAvailable := intstr.FromInt32(1)
+	maxUnavailable := intstr.FromInt32(0)
+	old := &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-budget",
+			Namespace: "default",
+		},
+		Spec: policy.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+		},
+	}
+	new := skres.PodDisruptionBudget{
+		Name:           "my-budget",
+		MaxUnavailable: &maxUnavailable,
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PodDisruptionBudget](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PodDisruptionBudget().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.PodDisruptionBudget](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PodDisruptionBudget().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*policy.PodDisruptionBudget)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, 0, obj.Spec.MaxUnavailable.IntValue())
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestPodDisruptionBudgetGet(t *testing.T) {
+	maxUnavailable := intstr.FromInt32(1)
+	kubeBudget := &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-budget",
+			Namespace: "default",
+		},
+		Spec: policy.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "nginx"},
+			},
+			MaxUnavailable: &maxUnavailable,
+		},
+		Status: policy.PodDisruptionBudgetStatus{
+			CurrentHealthy:     3,
+			DesiredHealthy:     2,
+			ExpectedPods:       3,
+			DisruptionsAllowed: 1,
+		},
+	}
+	expected := skres.PodDisruptionBudget{
+		Name: "my-budget",
+		Selector: skres.LabelSelector{
+			MatchLabels: map[string]string{"app": "nginx"},
+		},
+		MaxUnavailable: &maxUnavailable,
+		Status: skres.PodDisruptionBudgetStatus{
+			CurrentHealthy:     3,
+			DesiredHealthy:     2,
+			ExpectedPods:       3,
+			DisruptionsAllowed: 1,
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeBudget))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Get("my-budget")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Get("my-budget").
+			DataHandler(func(res interface{}) error {
+				budget := res.(*policy.PodDisruptionBudget)
+				budget.Status.DisruptionsAllowed = 0
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, result.Status.DisruptionsAllowed)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Get("my-budget").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestPodDisruptionBudgetList(t *testing.T) {
+	budget1 := &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-budget",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	budget2 := &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-budget2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(budget1, budget2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestPodDisruptionBudgetDelete(t *testing.T) {
+	budget := &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-budget",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(budget)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			PodDisruptionBudget().
+			Delete("my-budget")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "poddisruptionbudgets"))
+	})
+}
@@ -0,0 +1,462 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPodCreate(t *testing.T) {
+	new := skres.Pod{
+		Name: "my-pod",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+		Status: skres.PodStatus{
+			Phase: api.PodRunning,
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Pod](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Pod().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.Pod](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Pod().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Pod)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should ignore status fields", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Pod().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				obj := res.(*api.Pod)
+				assert.Empty(t, obj.Status.Phase)
+				return nil
+			})
+		err := query.Run()
+
+		assert.Nil(t, err)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Pod().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+}
+
+func TestPodUpdate(t *testing.T) {
+	old := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod",
+			Namespace: "default",
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name:  "main",
+					Image: "sarasa",
+				},
+			},
+		},
+	}
+	new := skres.Pod{
+		Name: "my-pod",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa2",
+			},
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Pod](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Pod().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.Pod](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Pod().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Pod)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Pod().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+	t.Run("should keep the node of a scheduled pod", func(t *testing.T) {
+		scheduled := old.DeepCopy()
+		scheduled.Spec.NodeName = "some-node"
+		k8s := fake.NewSimpleClientset(scheduled)
+		client := sk.NewClient(context.Background(), k8s)
+		pods := client.NamespacedQuery("default").Pod()
+
+		pod, err := pods.Get(scheduled.Name).Run()
+		assert.Nil(t, err)
+		pod.Labels = map[string]string{"app": "updated"}
+		err = pods.Update(pod).Run()
+		assert.Nil(t, err)
+
+		result, err := k8s.CoreV1().
+			Pods("default").
+			Get(context.Background(), scheduled.Name, metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "some-node", result.Spec.NodeName)
+		assert.Equal(t, "updated", result.Labels["app"])
+	})
+}
+
+func TestPodGet(t *testing.T) {
+	kubePod := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod",
+			Namespace: "default",
+		},
+		Spec: api.PodSpec{
+			NodeName: "some-node",
+			Containers: []api.Container{
+				{
+					Name:  "main",
+					Image: "sarasa",
+				},
+			},
+		},
+		Status: api.PodStatus{
+			Phase: api.PodRunning,
+			PodIP: "10.0.0.10",
+			ContainerStatuses: []api.ContainerStatus{
+				{
+					Name:         "main",
+					Ready:        true,
+					RestartCount: 2,
+					LastTerminationState: api.ContainerState{
+						Terminated: &api.ContainerStateTerminated{
+							Reason: "OOMKilled",
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := skres.Pod{
+		Name: "my-pod",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+		Node: "some-node",
+		Status: skres.PodStatus{
+			Phase: api.PodRunning,
+			IP:    "10.0.0.10",
+			Containers: []skres.ContainerStatus{
+				{
+					Name:                  "main",
+					Ready:                 true,
+					RestartCount:          2,
+					LastTerminationReason: "OOMKilled",
+				},
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubePod))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Pod().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Pod().
+			Get("my-pod")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Pod().
+			Get("my-pod").
+			DataHandler(func(res interface{}) error {
+				pod := res.(*api.Pod)
+				pod.Spec.Containers[0].Image = "overrided"
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "overrided", result.Containers[0].Image)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Pod().
+			Get("my-pod").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestPodList(t *testing.T) {
+	templateLabels := map[string]string{
+		"app": "nginx",
+	}
+	dpl := &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment",
+			Namespace: "default",
+		},
+		Spec: apps.DeploymentSpec{
+			Template: api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: templateLabels,
+				},
+			},
+		},
+	}
+	pod1 := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+		Status: api.PodStatus{
+			Phase: api.PodRunning,
+		},
+	}
+	pod2 := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(dpl, pod1, pod2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Pod().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by deployment template labels", func(t *testing.T) {
+		deployment, err := client.NamespacedQuery("default").
+			Deployment().
+			Get("my-deployment").
+			Run()
+		assert.Nil(t, err)
+
+		query := client.NamespacedQuery("default").
+			Pod().
+			List().
+			FilterByLabels(deployment.TemplateLabels)
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+		assert.Equal(t, "my-pod", result[0].Name)
+		assert.Equal(t, api.PodRunning, result[0].Status.Phase)
+	})
+}
+
+func TestPodDelete(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(pod)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Pod().
+			Delete("my-pod")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "pods"))
+	})
+}
+
+func TestPodScheduling(t *testing.T) {
+	new := skres.Pod{
+		Name: "my-pod",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "sarasa",
+			},
+		},
+		Scheduling: skres.PodScheduling{
+			Tolerations: []skres.Toleration{
+				{
+					Key:      "dedicated",
+					Operator: api.TolerationOpExists,
+				},
+			},
+			PodAntiAffinity: &skres.PodAffinity{
+				Required: []skres.PodAffinityTerm{
+					{
+						Selector: &skres.LabelSelector{
+							MatchLabels: map[string]string{"app": "sarasa"},
+						},
+						TopologyKey: "kubernetes.io/hostname",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("should dump scheduling controls into the pod spec", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		err := client.NamespacedQuery("default").
+			Pod().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				spec := res.(*api.Pod).Spec
+				assert.Equal(t, "dedicated", spec.Tolerations[0].Key)
+				assert.Nil(t, spec.Affinity.NodeAffinity)
+				terms := spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+				assert.Equal(t, "kubernetes.io/hostname", terms[0].TopologyKey)
+				return nil
+			}).
+			Run()
+		assert.Nil(t, err)
+
+		result, err := client.NamespacedQuery("default").
+			Pod().
+			Get("my-pod").
+			Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, new.Scheduling, result.Scheduling)
+	})
+}
+
+func TestPodDumpInvalidObject(t *testing.T) {
+	res, err := skres.Pod{}.Dump(&skres.Pod{Name: "my-pod"})
+
+	assert.Nil(t, res)
+	assert.Equal(
+		t,
+		"invalid object: expected resources.Pod, got *resources.Pod",
+		err.Error(),
+	)
+}
@@ -0,0 +1,330 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPersistentVolumeClaimCreate(t *testing.T) {
+	new := skres.PersistentVolumeClaim{
+		Name:         "my-claim",
+		StorageClass: "standard",
+		AccessModes:  []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+		Size:         "10Gi",
+		VolumeMode:   api.PersistentVolumeFilesystem,
+		Volume:       "pv-123",
+		Status: skres.PersistentVolumeClaimStatus{
+			Phase: api.ClaimBound,
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolumeClaim](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PersistentVolumeClaim().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolumeClaim](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PersistentVolumeClaim().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.PersistentVolumeClaim)
+					size := obj.Spec.Resources.Requests[api.ResourceStorage]
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "10Gi", size.String())
+					assert.Equal(t, "pv-123", obj.Spec.VolumeName)
+					assert.Empty(t, obj.Status.Phase)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+}
+
+func TestPersistentVolumeClaimUpdate(t *testing.T) {
+	old := &api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-claim",
+			Namespace: "default",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			Resources: api.VolumeResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceStorage: resource.MustParse("10Gi"),
+				},
+			},
+		},
+	}
+	new := skres.PersistentVolumeClaim{
+		Name: "my-claim",
+		Size: "20Gi",
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolumeClaim](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PersistentVolumeClaim().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.PersistentVolumeClaim](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				PersistentVolumeClaim().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.PersistentVolumeClaim)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+	t.Run("should keep the bound volume when resizing", func(t *testing.T) {
+		bound := old.DeepCopy()
+		bound.Spec.VolumeName = "pv-123"
+		k8s := fake.NewSimpleClientset(bound)
+		client := sk.NewClient(context.Background(), k8s)
+		claims := client.NamespacedQuery("default").PersistentVolumeClaim()
+
+		claim, err := claims.Get("my-claim").Run()
+		assert.Nil(t, err)
+		claim.Size = "20Gi"
+		err = claims.Update(claim).Run()
+		assert.Nil(t, err)
+
+		result, err := k8s.CoreV1().
+			PersistentVolumeClaims("default").
+			Get(context.Background(), "my-claim", metav1.GetOptions{})
+		size := result.Spec.Resources.Requests[api.ResourceStorage]
+		assert.Nil(t, err)
+		assert.Equal(t, "pv-123", result.Spec.VolumeName)
+		assert.Equal(t, "20Gi", size.String())
+	})
+}
+
+func TestPersistentVolumeClaimGet(t *testing.T) {
+	storageClass := "standard"
+	kubeClaim := &api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-claim",
+			Namespace: "default",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			StorageClassName: &storageClass,
+			AccessModes: []api.PersistentVolumeAccessMode{
+				api.ReadWriteOnce,
+			},
+			Resources: api.VolumeResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceStorage: resource.MustParse("10Gi"),
+				},
+			},
+			VolumeName: "pv-123",
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase: api.ClaimBound,
+		},
+	}
+	expected := skres.PersistentVolumeClaim{
+		Name:         "my-claim",
+		StorageClass: "standard",
+		AccessModes:  []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+		Size:         "10Gi",
+		Volume:       "pv-123",
+		Status: skres.PersistentVolumeClaimStatus{
+			Phase: api.ClaimBound,
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeClaim))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Get("my-claim")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Get("my-claim").
+			DataHandler(func(res interface{}) error {
+				claim := res.(*api.PersistentVolumeClaim)
+				claim.Status.Phase = api.ClaimLost
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, api.ClaimLost, result.Status.Phase)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Get("my-claim").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestPersistentVolumeClaimList(t *testing.T) {
+	claim1 := &api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-claim",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "postgres",
+				"some": "label",
+			},
+		},
+	}
+	claim2 := &api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-claim2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(claim1, claim2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "postgres",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestPersistentVolumeClaimDelete(t *testing.T) {
+	claim := &api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-claim",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(claim)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			PersistentVolumeClaim().
+			Delete("my-claim")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "persistentvolumeclaims"))
+	})
+}
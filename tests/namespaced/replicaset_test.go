@@ -0,0 +1,201 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReplicaSetGet(t *testing.T) {
+	replicas := int32(2)
+	created := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	kubeSet := &apps.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-deployment-abc",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations: map[string]string{
+				"deployment.kubernetes.io/revision": "4",
+			},
+		},
+		Spec: apps.ReplicaSetSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "nginx"},
+			},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "nginx"},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: "nginx",
+						},
+					},
+				},
+			},
+		},
+		Status: apps.ReplicaSetStatus{
+			Replicas:          2,
+			ReadyReplicas:     1,
+			AvailableReplicas: 1,
+		},
+	}
+	expected := skres.ReplicaSet{
+		Name: "my-deployment-abc",
+		Annotations: map[string]string{
+			"deployment.kubernetes.io/revision": "4",
+		},
+		Replicas: 2,
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "nginx",
+			},
+		},
+		TemplateLabels:  map[string]string{"app": "nginx"},
+		ServiceSelector: map[string]string{"app": "nginx"},
+		Status: skres.ReplicaSetStatus{
+			Created:           skres.NewTimestamp(created),
+			Replicas:          2,
+			ReadyReplicas:     1,
+			AvailableReplicas: 1,
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeSet))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			Get("my-deployment-abc")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			Get("my-deployment-abc").
+			DataHandler(func(res interface{}) error {
+				set := res.(*apps.ReplicaSet)
+				set.Status.ReadyReplicas = 2
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result.Status.ReadyReplicas)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			Get("my-deployment-abc").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+	t.Run("should ignore status when dumping", func(t *testing.T) {
+		obj, err := expected.Dump(expected)
+
+		assert.Nil(t, err)
+		set := obj.(*apps.ReplicaSet)
+		assert.Equal(t, int32(0), set.Status.ReadyReplicas)
+		assert.True(t, set.CreationTimestamp.IsZero())
+	})
+}
+
+func TestReplicaSetList(t *testing.T) {
+	set1 := &apps.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment-abc",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	set2 := &apps.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment-def",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(set1, set2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestReplicaSetDelete(t *testing.T) {
+	set := &apps.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment-abc",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(set)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			ReplicaSet().
+			Delete("my-deployment-abc")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "replicasets"))
+	})
+}
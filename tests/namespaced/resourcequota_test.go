@@ -0,0 +1,391 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResourceQuotaCreate(t *testing.T) {
+	new := skres.ResourceQuota{
+		Name: "my-quota",
+		Hard: map[string]string{
+			"requests.cpu":    "4",
+			"requests.memory": "8Gi",
+			"pods":            "20",
+		},
+		Scopes: []api.ResourceQuotaScope{api.ResourceQuotaScopeNotTerminating},
+		Status: skres.ResourceQuotaStatus{
+			Used: map[string]string{"pods": "1"},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ResourceQuota](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				ResourceQuota().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.ResourceQuota](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				ResourceQuota().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.ResourceQuota)
+					memory := obj.Spec.Hard[api.ResourceRequestsMemory]
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, "8Gi", memory.String())
+					assert.Equal(t, new.Scopes, obj.Spec.Scopes)
+					assert.Empty(t, obj.Status.Used)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestResourceQuotaUpdate(t *testing.T) {
+	old := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-quota",
+			Namespace: "default",
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: api.ResourceList{
+				api.ResourcePods: resource.MustParse("10"),
+			},
+		},
+	}
+	new := skres.ResourceQuota{
+		Name: "my-quota",
+		Hard: map[string]string{"pods": "20"},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.ResourceQuota](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				ResourceQuota().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.ResourceQuota](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				ResourceQuota().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.ResourceQuota)
+					pods := obj.Spec.Hard[api.ResourcePods]
+					assert.Equal(t, "20", pods.String())
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestResourceQuotaGet(t *testing.T) {
+	kubeQuota := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-quota",
+			Namespace: "default",
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: api.ResourceList{
+				api.ResourcePods: resource.MustParse("10"),
+			},
+		},
+		Status: api.ResourceQuotaStatus{
+			Hard: api.ResourceList{
+				api.ResourcePods: resource.MustParse("10"),
+			},
+			Used: api.ResourceList{
+				api.ResourcePods: resource.MustParse("3"),
+			},
+		},
+	}
+	expected := skres.ResourceQuota{
+		Name: "my-quota",
+		Hard: map[string]string{"pods": "10"},
+		Status: skres.ResourceQuotaStatus{
+			Hard: map[string]string{"pods": "10"},
+			Used: map[string]string{"pods": "3"},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeQuota))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Get("my-quota")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Get("my-quota").
+			DataHandler(func(res interface{}) error {
+				quota := res.(*api.ResourceQuota)
+				quota.Status.Used[api.ResourcePods] = resource.MustParse("5")
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "5", result.Status.Used["pods"])
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Get("my-quota").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestResourceQuotaList(t *testing.T) {
+	quota1 := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-quota",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	quota2 := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-quota2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(quota1, quota2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestResourceQuotaDelete(t *testing.T) {
+	quota := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-quota",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(quota)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Delete("my-quota")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "resourcequotas"))
+	})
+}
+
+func TestResourceQuotaUtilization(t *testing.T) {
+	compute := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "compute",
+			Namespace: "default",
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: api.ResourceList{
+				api.ResourceRequestsCPU:    resource.MustParse("4"),
+				api.ResourceRequestsMemory: resource.MustParse("8Gi"),
+			},
+		},
+		Status: api.ResourceQuotaStatus{
+			Used: api.ResourceList{
+				api.ResourceRequestsCPU:    resource.MustParse("500m"),
+				api.ResourceRequestsMemory: resource.MustParse("6Gi"),
+			},
+		},
+	}
+	objects := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "objects",
+			Namespace: "default",
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: api.ResourceList{
+				api.ResourcePods:     resource.MustParse("10"),
+				api.ResourceSecrets:  resource.MustParse("0"),
+				api.ResourceServices: resource.MustParse("5"),
+			},
+		},
+		Status: api.ResourceQuotaStatus{
+			Used: api.ResourceList{
+				api.ResourcePods: resource.MustParse("10"),
+			},
+		},
+	}
+	other := &api.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other",
+			Namespace: "other",
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: api.ResourceList{
+				api.ResourcePods: resource.MustParse("1"),
+			},
+		},
+	}
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(objects, compute, other),
+	)
+
+	t.Run("should report usage of every quota in the namespace", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			ResourceQuota().
+			Utilization()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []skres.QuotaUsage{
+			{Quota: "compute", Resource: "requests.cpu", Used: "500m", Hard: "4", Percent: 12.5},
+			{Quota: "compute", Resource: "requests.memory", Used: "6Gi", Hard: "8Gi", Percent: 75},
+			{Quota: "objects", Resource: "pods", Used: "10", Hard: "10", Percent: 100},
+			{Quota: "objects", Resource: "secrets", Used: "0", Hard: "0", Percent: 100},
+			{Quota: "objects", Resource: "services", Used: "0", Hard: "5", Percent: 0},
+		}, result)
+	})
+	t.Run("should return an empty report when there are no quotas", func(t *testing.T) {
+		query := client.NamespacedQuery("empty").
+			ResourceQuota().
+			Utilization()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Empty(t, result)
+	})
+	t.Run("should fail on invalid quantities", func(t *testing.T) {
+		quota := skres.ResourceQuota{
+			Name: "broken",
+			Hard: map[string]string{"pods": "many"},
+		}
+		_, err := quota.Utilization()
+
+		assert.NotNil(t, err)
+	})
+}
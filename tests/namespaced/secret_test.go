@@ -0,0 +1,307 @@
+package namespaced_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSecretCreate(t *testing.T) {
+	new := skres.NewBasicAuthSecret("my-secret", "admin", "s3cr3t")
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Secret](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Secret().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.Secret](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Secret().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Secret)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, api.SecretTypeBasicAuth, obj.Type)
+					assert.Equal(t, []byte("s3cr3t"), obj.Data[api.BasicAuthPasswordKey])
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Secret().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+}
+
+func TestSecretUpdate(t *testing.T) {
+	old := &api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "default",
+		},
+		Type: api.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"key": []byte("value"),
+		},
+	}
+	new := skres.NewOpaqueSecret("my-secret", map[string][]byte{
+		"key": []byte("new value"),
+	})
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.Secret](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Secret().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.Secret](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				Secret().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*api.Secret)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Secret().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestSecretGet(t *testing.T) {
+	kubeSecret := &api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "default",
+		},
+		Type: api.SecretTypeTLS,
+		Data: map[string][]byte{
+			api.TLSCertKey:       []byte("cert"),
+			api.TLSPrivateKeyKey: []byte("key"),
+		},
+	}
+	expected := skres.NewTLSSecret("my-secret", []byte("cert"), []byte("key"))
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeSecret))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Secret().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Secret().
+			Get("my-secret")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Secret().
+			Get("my-secret").
+			DataHandler(func(res interface{}) error {
+				secret := res.(*api.Secret)
+				secret.Data[api.TLSCertKey] = []byte("overrided")
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("overrided"), result.Data[api.TLSCertKey])
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Secret().
+			Get("my-secret").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestSecretList(t *testing.T) {
+	secret1 := &api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "nginx",
+				"some": "label",
+			},
+		},
+	}
+	secret2 := &api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(secret1, secret2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Secret().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			Secret().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "nginx",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestSecretDelete(t *testing.T) {
+	secret := &api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(secret)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			Secret().
+			Delete("my-secret")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "secrets"))
+	})
+}
+
+func TestSecretHelpers(t *testing.T) {
+	t.Run("should build docker registry credentials", func(t *testing.T) {
+		secret, err := skres.NewDockerConfigSecret("registry", "ghcr.io", "user", "pass")
+		assert.Nil(t, err)
+		assert.Equal(t, api.SecretTypeDockerConfigJson, secret.Type)
+
+		var config map[string]map[string]map[string]string
+		err = json.Unmarshal(secret.Data[api.DockerConfigJsonKey], &config)
+		assert.Nil(t, err)
+		assert.Equal(t, "user", config["auths"]["ghcr.io"]["username"])
+		assert.Equal(t, "pass", config["auths"]["ghcr.io"]["password"])
+		assert.Equal(t, "dXNlcjpwYXNz", config["auths"]["ghcr.io"]["auth"])
+	})
+	t.Run("should build tls secret keys", func(t *testing.T) {
+		secret := skres.NewTLSSecret("tls", []byte("cert"), []byte("key"))
+
+		assert.Equal(t, api.SecretTypeTLS, secret.Type)
+		assert.Equal(t, []byte("cert"), secret.Data[api.TLSCertKey])
+		assert.Equal(t, []byte("key"), secret.Data[api.TLSPrivateKeyKey])
+	})
+	t.Run("should never print secret values", func(t *testing.T) {
+		secret := skres.NewBasicAuthSecret("auth", "admin", "s3cr3t")
+
+		for _, format := range []string{"%v", "%+v", "%#v", "%s", "%x", "%q"} {
+			printed := fmt.Sprintf(format, secret)
+			assert.NotContains(t, printed, "s3cr3t", format)
+			assert.NotContains(t, printed, "admin", format)
+			assert.NotContains(t, printed, fmt.Sprintf("%x", "s3cr3t"), format)
+		}
+		assert.Contains(t, secret.Data.String(), api.BasicAuthPasswordKey)
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	api "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -326,3 +327,142 @@ func TestServiceDelete(t *testing.T) {
 		assert.True(t, k8s.Actions()[0].Matches("delete", "services"))
 	})
 }
+
+func TestServiceEndpoints(t *testing.T) {
+	ready := true
+	notReady := false
+	node := "node-1"
+	portName := "http"
+	port := int32(8080)
+	protocol := api.ProtocolTCP
+	service := &api.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-service",
+			Namespace: "default",
+		},
+	}
+	idle := &api.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "idle",
+			Namespace: "default",
+		},
+	}
+	newSlice := func(name, service string, endpoints ...discovery.Endpoint) *discovery.EndpointSlice {
+		return &discovery.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					discovery.LabelServiceName: service,
+				},
+			},
+			AddressType: discovery.AddressTypeIPv4,
+			Endpoints:   endpoints,
+			Ports: []discovery.EndpointPort{
+				{
+					Name:     &portName,
+					Port:     &port,
+					Protocol: &protocol,
+				},
+			},
+		}
+	}
+	slices := []*discovery.EndpointSlice{
+		newSlice(
+			"my-service-abc",
+			"my-service",
+			discovery.Endpoint{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discovery.EndpointConditions{Ready: &ready},
+				NodeName:   &node,
+				TargetRef:  &api.ObjectReference{Kind: "Pod", Name: "my-pod-1"},
+			},
+			discovery.Endpoint{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discovery.EndpointConditions{Ready: &notReady},
+				TargetRef:  &api.ObjectReference{Kind: "Pod", Name: "my-pod-2"},
+			},
+		),
+		newSlice(
+			"my-service-def",
+			"my-service",
+			discovery.Endpoint{
+				Addresses: []string{"10.0.0.3"},
+			},
+		),
+		newSlice(
+			"other-abc",
+			"other",
+			discovery.Endpoint{
+				Addresses:  []string{"10.0.1.1"},
+				Conditions: discovery.EndpointConditions{Ready: &ready},
+			},
+		),
+		newSlice(
+			"idle-abc",
+			"idle",
+			discovery.Endpoint{
+				Addresses:  []string{"10.0.2.1"},
+				Conditions: discovery.EndpointConditions{Ready: &notReady},
+			},
+		),
+	}
+	k8s := fake.NewSimpleClientset(service, idle)
+	for _, slice := range slices {
+		assert.Nil(t, k8s.Tracker().Add(slice))
+	}
+	client := sk.NewClient(context.Background(), k8s)
+
+	t.Run("should split ready and not ready endpoints", func(t *testing.T) {
+		result, err := client.NamespacedQuery("default").
+			Service().
+			Endpoints("my-service").
+			Run()
+
+		assert.Nil(t, err)
+		assert.True(t, result.HasReadyEndpoints())
+		assert.ElementsMatch(t, []skres.Endpoint{
+			{
+				Address:  "10.0.0.1",
+				Pod:      "my-pod-1",
+				Node:     "node-1",
+				Port:     8080,
+				PortName: "http",
+				Protocol: api.ProtocolTCP,
+			},
+			{
+				Address:  "10.0.0.3",
+				Port:     8080,
+				PortName: "http",
+				Protocol: api.ProtocolTCP,
+			},
+		}, result.Ready)
+		assert.Equal(t, []skres.Endpoint{
+			{
+				Address:  "10.0.0.2",
+				Pod:      "my-pod-2",
+				Port:     8080,
+				PortName: "http",
+				Protocol: api.ProtocolTCP,
+			},
+		}, result.NotReady)
+	})
+	t.Run("should report services without ready endpoints", func(t *testing.T) {
+		result, err := client.NamespacedQuery("default").
+			Service().
+			Endpoints("idle").
+			Run()
+
+		assert.Nil(t, err)
+		assert.False(t, result.HasReadyEndpoints())
+		assert.Equal(t, 1, len(result.NotReady))
+	})
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		_, err := client.NamespacedQuery("default").
+			Service().
+			Endpoints("not-found").
+			Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+}
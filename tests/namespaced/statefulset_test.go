@@ -0,0 +1,353 @@
+package namespaced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sk "github.com/ilexPar/simple-kube/pkg"
+	skerr "github.com/ilexPar/simple-kube/pkg/errors"
+	skres "github.com/ilexPar/simple-kube/pkg/namespaced/resources"
+	kt "github.com/ilexPar/simple-kube/tests/k8sutil"
+
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStatefulSetCreate(t *testing.T) {
+	new := skres.StatefulSet{
+		Name:        "my-db",
+		ServiceName: "my-db",
+		Replicas:    3,
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "postgres",
+			},
+		},
+		VolumeClaimTemplates: []skres.VolumeClaimTemplate{
+			{
+				Name:        "data",
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Size:        "1Gi",
+			},
+		},
+	}
+
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.StatefulSet](t, kt.Create, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				StatefulSet().
+				Create(new)
+			err := query.Run()
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		kt.WithInformedClient[skres.StatefulSet](t, kt.Create, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				StatefulSet().
+				Create(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*apps.StatefulSet)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, int32(3), *obj.Spec.Replicas)
+					assert.Equal(t, "data", obj.Spec.VolumeClaimTemplates[0].Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		})
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset()
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Create(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+
+	})
+}
+
+func TestStatefulSetUpdate(t *testing.T) {
+	old := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-db",
+			Namespace: "default",
+		},
+		Spec: apps.StatefulSetSpec{
+			ServiceName: "my-db",
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: "postgres",
+						},
+					},
+				},
+			},
+		},
+	}
+	new := skres.StatefulSet{
+		Name:        "my-db",
+		ServiceName: "my-db",
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "postgres2",
+			},
+		},
+	}
+	t.Run("should success without errors", func(t *testing.T) {
+		kt.WithInformedClient[skres.StatefulSet](t, kt.Update, func(k8s *fake.Clientset) {
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				StatefulSet().
+				Update(new)
+
+			err := query.Run()
+
+			assert.Nil(t, err)
+		}, old)
+	})
+	t.Run("should run DataHandler callback before updating object", func(t *testing.T) {
+		kt.WithInformedClient[skres.StatefulSet](t, kt.Update, func(k8s *fake.Clientset) {
+			hasCallbackRun := false
+			baseKubeActions := 2 // kube fake clients with informers starts with 2 actions
+			client := sk.NewClient(context.Background(), k8s)
+
+			query := client.NamespacedQuery("default").
+				StatefulSet().
+				Update(new).
+				DataHandler(func(res interface{}) error {
+					obj := res.(*apps.StatefulSet)
+					assert.Equal(t, new.Name, obj.Name)
+					assert.Equal(t, baseKubeActions, len(k8s.Actions()))
+					hasCallbackRun = true
+					return nil
+				})
+			err := query.Run()
+
+			assert.Nil(t, err)
+			assert.True(t, hasCallbackRun)
+			assert.Equal(t, baseKubeActions+1, len(k8s.Actions()))
+		}, old)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(old)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Update(new).
+			DataHandler(func(res interface{}) error {
+				return errors.New("test error")
+			})
+		err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+		assert.Equal(t, 0, len(k8s.Actions()))
+	})
+}
+
+func TestStatefulSetGet(t *testing.T) {
+	replicas := int32(3)
+	kubeStatefulSet := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-db",
+			Namespace: "default",
+		},
+		Spec: apps.StatefulSetSpec{
+			ServiceName:         "my-db",
+			Replicas:            &replicas,
+			PodManagementPolicy: apps.ParallelPodManagement,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "main",
+							Image: "postgres",
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "data",
+					},
+					Spec: v1.PersistentVolumeClaimSpec{
+						AccessModes: []v1.PersistentVolumeAccessMode{
+							v1.ReadWriteOnce,
+						},
+						Resources: v1.VolumeResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceStorage: resource.MustParse("1Gi"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := skres.StatefulSet{
+		Name:                "my-db",
+		ServiceName:         "my-db",
+		Replicas:            3,
+		PodManagementPolicy: apps.ParallelPodManagement,
+		Containers: []skres.Container{
+			{
+				Name:  "main",
+				Image: "postgres",
+			},
+		},
+		VolumeClaimTemplates: []skres.VolumeClaimTemplate{
+			{
+				Name:        "data",
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Size:        "1Gi",
+			},
+		},
+	}
+	client := sk.NewClient(context.Background(), fake.NewSimpleClientset(kubeStatefulSet))
+
+	t.Run("should return custom error when not found", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Get("not-found")
+		_, err := query.Run()
+
+		assert.Equal(t, skerr.ERROR_NOT_FOUND, err.Error())
+	})
+	t.Run("should return expected object", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Get("my-db")
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should run DataHandler callback", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Get("my-db").
+			DataHandler(func(res interface{}) error {
+				sts := res.(*apps.StatefulSet)
+				sts.Spec.Template.Spec.Containers[0].Image = "overrided"
+				return nil
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "overrided", result.Containers[0].Image)
+	})
+	t.Run("should cancel execution on callback error", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Get("my-db").
+			DataHandler(func(interface{}) error {
+				return errors.New("test error")
+			})
+		_, err := query.Run()
+
+		assert.Equal(t, "test error", err.Error())
+	})
+}
+
+func TestStatefulSetList(t *testing.T) {
+	sts1 := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-db",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":  "postgres",
+				"some": "label",
+			},
+		},
+	}
+	sts2 := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-db2",
+			Namespace: "default",
+			Labels: map[string]string{
+				"some": "label",
+			},
+		},
+	}
+
+	client := sk.NewClient(
+		context.Background(),
+		fake.NewSimpleClientset(sts1, sts2),
+	)
+
+	t.Run("should return expected objects", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			List()
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+	})
+	t.Run("should filter by label", func(t *testing.T) {
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			List().
+			FilterByLabels(map[string]string{
+				"app": "postgres",
+			})
+		result, err := query.Run()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+	})
+}
+
+func TestStatefulSetDelete(t *testing.T) {
+	sts := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-db",
+			Namespace: "default",
+		},
+	}
+	t.Run("should return no errors when calling delete on an object", func(t *testing.T) {
+		k8s := fake.NewSimpleClientset(sts)
+		client := sk.NewClient(context.Background(), k8s)
+
+		query := client.NamespacedQuery("default").
+			StatefulSet().
+			Delete("my-db")
+
+		err := query.Run()
+
+		assert.Nil(t, err)
+		assert.True(t, k8s.Actions()[0].Matches("delete", "statefulsets"))
+	})
+}